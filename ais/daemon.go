@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -155,6 +156,13 @@ func initDaemon(version, buildTime string) cos.Runner {
 	}
 	cmn.GCO.Put(config)
 
+	// debug build: round-trip all jsp (metadata persistence) options
+	if debug.ON() {
+		if err := jsp.SelfTest(); err != nil {
+			cos.ExitLog(err)
+		}
+	}
+
 	// Examples overriding default configuration at a node startup via command line:
 	// 1) set client timeout to 13s and store the updated value on disk:
 	// $ aisnode -config=/etc/ais.json -local_config=/etc/ais_local.json -role=target \
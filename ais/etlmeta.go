@@ -90,7 +90,7 @@ func (*etlMD) sgl() *memsys.SGL  { return nil }
 // always remarshal (TODO: unify and optimize across all cluster-level metadata types)
 func (e *etlMD) marshal() []byte {
 	sgl := memsys.PageMM().NewSGL(etlMDImmSize)
-	err := jsp.Encode(sgl, e, jsp.CCSign(cmn.MetaverEtlMD))
+	err := jsp.Encode(sgl, e, e.JspOpts())
 	debug.AssertNoErr(err)
 	etlMDImmSize = max(etlMDImmSize, sgl.Len())
 	b := sgl.ReadAll() // TODO: optimize
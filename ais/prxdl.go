@@ -33,19 +33,30 @@ func (p *proxy) dloadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	switch r.Method {
-	case http.MethodGet, http.MethodDelete:
+	case http.MethodGet:
+		if r.URL.Path == apc.URLPathDownloadSumm.S {
+			p.dlsummary(w, r)
+			return
+		}
+		p.httpdladm(w, r)
+	case http.MethodDelete, http.MethodPut:
 		p.httpdladm(w, r)
 	case http.MethodPost:
 		p.httpdlpost(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
 	}
 }
 
 // +gen:endpoint GET /v1/download
 // +gen:endpoint DELETE /v1/download/abort
 // +gen:endpoint DELETE /v1/download/remove
-// Get download status/list or abort/remove download jobs
+// +gen:endpoint DELETE /v1/download/cancel-item
+// +gen:endpoint PUT /v1/download/priority
+// +gen:endpoint PUT /v1/download/pause
+// +gen:endpoint PUT /v1/download/resume
+// Get download status/list, abort/remove download jobs, cancel individual items, change job priority,
+// or pause (resume) all downloads
 func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 	if !p.ClusterStarted() {
 		w.WriteHeader(http.StatusServiceUnavailable)
@@ -55,20 +66,48 @@ func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 	if err := cmn.ReadJSON(w, r, &msg); err != nil {
 		return
 	}
-	if err := msg.Validate(r.Method == http.MethodDelete); err != nil {
-		p.writeErr(w, r, err)
-		return
-	}
-
-	if r.Method == http.MethodDelete {
+	var action string
+	if r.Method != http.MethodGet {
 		items, err := cmn.ParseURL(r.URL.Path, apc.URLPathDownload.L, 1, false)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+		action = items[0]
+	}
+	global := r.Method == http.MethodPut && (action == apc.Pause || action == apc.Resume)
+	if err := msg.Validate(r.Method != http.MethodGet && !global); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
 
-		if items[0] != apc.Abort && items[0] != apc.Remove {
-			p.writeErrAct(w, r, items[0])
+	if r.Method != http.MethodGet {
+		switch {
+		case global:
+			if msg.ID != "" || msg.Regex != "" || msg.Item != "" {
+				p.writeErrf(w, r, "%s: %s applies to all download jobs (not expecting job ID, regex, or item)", p, action)
+				return
+			}
+		case r.Method == http.MethodPut && action == apc.Priority:
+			if msg.Item != "" || msg.Regex != "" {
+				p.writeErrf(w, r, "%s: %s job %q: unexpected item or regex", p, action, msg.ID)
+				return
+			}
+		case r.Method == http.MethodPut:
+			p.writeErrAct(w, r, action)
+			return
+		case action == apc.Abort || action == apc.Remove:
+			if msg.Item != "" {
+				p.writeErrf(w, r, "%s: item %q: expecting %q (not %q)", p, msg.Item, apc.CancelItem, action)
+				return
+			}
+		case action == apc.CancelItem:
+			if msg.Item == "" {
+				p.writeErrf(w, r, "%s: %s job %q: item (object name or link) not specified", p, action, msg.ID)
+				return
+			}
+		default:
+			p.writeErrAct(w, r, action)
 			return
 		}
 	}
@@ -103,6 +142,11 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) {
+		p.dlpreflight(w, r, body)
+		return
+	}
+
 	var progressInterval = dload.DownloadProgressInterval
 	if dlBase.ProgressInterval != "" {
 		ival, err := time.ParseDuration(dlBase.ProgressInterval)
@@ -139,22 +183,28 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 
 func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, error) {
 	config := cmn.GCO.Get()
-	if msg.ID != "" && method == http.MethodGet && msg.OnlyActive {
+	if msg.ID != "" && method == http.MethodGet && msg.OnlyActive && !msg.Inflight && msg.Wait == 0 {
 		nl := p.notifs.entry(msg.ID)
 		if nl != nil {
 			respBytes := p.dlstatus(nl, config)
 			return respBytes, http.StatusOK, nil
 		}
 	}
+	// paginated list: have each target return its first (offset + limit) jobs
+	// (all targets run all jobs), aggregate, and cut the requested page
+	tmsg := msg
+	if msg.ID == "" && method == http.MethodGet && msg.Limit > 0 {
+		tmsg = &dload.AdminBody{Regex: msg.Regex, OnlyActive: msg.OnlyActive, Limit: msg.Offset + msg.Limit}
+	}
 	var (
-		body        = cos.MustMarshal(msg)
+		body        = cos.MustMarshal(tmsg)
 		args        = allocBcArgs()
 		xid         = cos.GenUUID()
 		q           = url.Values{apc.QparamUUID: []string{xid}}
 		notFoundCnt int
 	)
 	args.req = cmn.HreqArgs{Method: method, Path: path, Body: body, Query: q}
-	args.timeout = config.Timeout.MaxHostBusy.D()
+	args.timeout = config.Timeout.MaxHostBusy.D() + msg.Wait // (long-poll)
 	results := p.bcastGroup(args)
 	defer freeBcastRes(results)
 	freeBcArgs(args)
@@ -189,6 +239,9 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 
 	switch method {
 	case http.MethodGet:
+		if msg.ID == "" && msg.Limit > 0 {
+			return dlpage(validResponses, msg.Offset, msg.Limit)
+		}
 		if msg.ID == "" {
 			// If ID is empty, return the list of downloads
 			aggregate := make(map[string]*dload.Job)
@@ -226,7 +279,10 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 		}
 		body := cos.MustMarshal(stResp)
 		return body, http.StatusOK, nil
-	case http.MethodDelete:
+	case http.MethodDelete, http.MethodPut:
+		if msg.Item != "" {
+			return dlcancel(validResponses)
+		}
 		res := validResponses[0]
 		return res.bytes, res.status, res.err
 	default:
@@ -235,6 +291,50 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 	}
 }
 
+// (see dload.JobPage)
+func dlpage(validResponses []*callResult, offset, limit int) ([]byte, int, error) {
+	var (
+		aggregate = make(map[string]*dload.Job, limit)
+		total     int
+	)
+	for _, resp := range validResponses {
+		var page dload.JobPage
+		if err := jsoniter.Unmarshal(resp.bytes, &page); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		total = max(total, page.Total)
+		for _, v := range page.Jobs {
+			if prev, ok := aggregate[v.ID]; ok {
+				v.Aggregate(prev)
+			}
+			aggregate[v.ID] = v
+		}
+	}
+	jobs := make(dload.JobInfos, 0, len(aggregate))
+	for _, v := range aggregate {
+		jobs = append(jobs, v)
+	}
+	jobs.SortByStart()
+	page := dload.JobPage{Jobs: dload.JobInfos{}, Total: max(total, len(jobs))}
+	if offset < len(jobs) {
+		page.Jobs = jobs[offset:min(offset+limit, len(jobs))]
+	}
+	return cos.MustMarshal(page), http.StatusOK, nil
+}
+
+// (see dload.CancelItemResp)
+func dlcancel(validResponses []*callResult) ([]byte, int, error) {
+	var total dload.CancelItemResp
+	for _, resp := range validResponses {
+		var cresp dload.CancelItemResp
+		if err := jsoniter.Unmarshal(resp.bytes, &cresp); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		total.Cancelled += cresp.Cancelled
+	}
+	return cos.MustMarshal(total), http.StatusOK, nil
+}
+
 func (p *proxy) dlstatus(nl nl.Listener, config *cmn.Config) []byte {
 	// bcast
 	p.notifs.bcastGetStats(nl, config.Periodic.NotifTime.D())
@@ -285,6 +385,66 @@ func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode
 	return
 }
 
+// dry-run: sum up per-target estimates (see dload.Preflight)
+func (p *proxy) dlpreflight(w http.ResponseWriter, r *http.Request, body []byte) {
+	var (
+		config = cmn.GCO.Get()
+		query  = url.Values{apc.QparamDryRun: []string{"true"}}
+		args   = allocBcArgs()
+		est    = &dload.Estimate{}
+	)
+	query.Set(apc.QparamUUID, cos.GenUUID())
+	query.Set(apc.QparamJobID, xact.PrefixDnlID+cos.GenUUID())
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: r.URL.Path, Body: body, Query: query}
+	args.timeout = config.Timeout.MaxHostBusy.D()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	defer freeBcastRes(results)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.err, res.status)
+			return
+		}
+		var rhs dload.Estimate
+		if err := jsoniter.Unmarshal(res.bytes, &rhs); err != nil {
+			p.writeErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		est.Merge(&rhs)
+	}
+	p.writeJSON(w, r, est, "download-preflight")
+}
+
+// +gen:endpoint GET /v1/download/summary
+// cluster-wide totals across all download jobs: sum up per-target summaries (see dload.Summary)
+func (p *proxy) dlsummary(w http.ResponseWriter, r *http.Request) {
+	var (
+		config = cmn.GCO.Get()
+		args   = allocBcArgs()
+		a      = &dload.DlAggregate{}
+	)
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDownloadSumm.S}
+	args.timeout = config.Timeout.MaxHostBusy.D()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	defer freeBcastRes(results)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.err, res.status)
+			return
+		}
+		var rhs dload.DlAggregate
+		if err := jsoniter.Unmarshal(res.bytes, &rhs); err != nil {
+			p.writeErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		a.Merge(&rhs)
+	}
+	p.writeJSON(w, r, a, "download-summary")
+}
+
 func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []byte) (dlb dload.Body, dlBase dload.Base, ok bool) {
 	if err := jsoniter.Unmarshal(body, &dlb); err != nil {
 		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download request", cos.BHead(body), err)
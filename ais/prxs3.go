@@ -576,6 +576,14 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		nlog.Infoln(r.Method, bck.Cname(objName), "=>", tsi.StringEx())
 	}
 
+	// part upload: cluster or bucket feature
+	if q := r.URL.Query(); q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID) {
+		if cmn.Rom.Features().IsSet(feat.S3MptReverseProxy) || bck.Props.Features.IsSet(feat.S3MptReverseProxy) {
+			p.s3ReverseProxy(w, r, tsi)
+			return
+		}
+	}
+
 	started := time.Now()
 	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraData, netPub)
 	p.s3Redirect(w, r, tsi, redurl, bck.Name)
@@ -817,12 +825,7 @@ func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket st
 // see also: docs/s3compat.md
 func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redurl, bucket string) {
 	if cmn.Rom.Features().IsSet(feat.S3ReverseProxy) {
-		// [intra-cluster communications]
-		// instead of regular HTTP redirect (below) reverse-proxy S3 API call to a designated target
-		// forward using pub net
-		parsedURL, err := url.Parse(si.URL(cmn.NetPublic))
-		debug.AssertNoErr(err)
-		p.reverseRequest(w, r, si.ID(), parsedURL)
+		p.s3ReverseProxy(w, r, si)
 		return
 	}
 
@@ -850,6 +853,15 @@ func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snod
 	w.Write(bb.Bytes())
 }
 
+// [intra-cluster communications]
+// instead of regular HTTP redirect reverse-proxy S3 API call to a designated target
+// forward using pub net
+func (p *proxy) s3ReverseProxy(w http.ResponseWriter, r *http.Request, si *meta.Snode) {
+	parsedURL, err := url.Parse(si.URL(cmn.NetPublic))
+	debug.AssertNoErr(err)
+	p.reverseRequest(w, r, si.ID(), parsedURL)
+}
+
 // extractEndpoint extracts an S3 endpoint from the full URL path.
 // Endpoint is a host name with port and root URL path (if exists).
 // E.g. for AIS `http://localhost:8080/s3/bck1/obj1` the endpoint
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -543,7 +544,18 @@ func (t *target) Run() error {
 
 	etl.Tinit()
 	t.initDsort(db, config) // note: conditional linkage
-	dload.Init(db, &config.Client)
+	if err := dload.Init(db, os.Getenv(env.AisDloadNamespace), &config.Client); err != nil {
+		cos.ExitLog(err)
+	}
+	if s := os.Getenv(env.AisDloadWorkers); s != "" {
+		n, err := strconv.Atoi(s)
+		if err == nil {
+			err = dload.SetWorkers(n)
+		}
+		if err != nil {
+			cos.ExitLogf("invalid %s=%q: %v", env.AisDloadWorkers, s, err)
+		}
+	}
 
 	err = t.htrun.run(config)
 
@@ -574,6 +586,7 @@ func (t *target) gojoin(config *cmn.Config) {
 		}
 	}
 	t.markClusterStarted()
+	go t.resumeDownloads()
 
 	if t.fsprg.newVol && !config.TestingEnv() {
 		config := cmn.GCO.BeginUpdate()
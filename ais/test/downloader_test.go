@@ -96,40 +96,32 @@ func checkDownloadList(t *testing.T, expNumEntries ...int) {
 	}
 }
 
+// long-poll (see api.DownloadWait) - no sleep-and-poll
 func waitForDownload(t *testing.T, id string, timeout time.Duration) {
 	var (
-		total          time.Duration
-		sleep          = cos.ProbingFrequency(timeout)
-		found, aborted bool
+		what     = id
+		aborted  bool
+		deadline = time.Now().Add(timeout)
 	)
-	for total < timeout {
-		time.Sleep(sleep)
-		total += sleep
-
-		what := id
-		finished := true
-		resp, err := api.DownloadStatus(tools.BaseAPIParams(), id, true)
-		if err == nil {
-			found = true
-			aborted = resp.Job.Aborted
-			what = resp.Job.String()
-			if l := len(resp.Errs); l > 0 {
-				what = fmt.Sprintf("%s (errs: %v)", resp.Job.String(), resp.Errs[:min(2, l)])
-			}
-			if !resp.JobFinished() {
-				finished = false
-			}
-		}
-		if finished && found {
-			return
+	for wait := time.Until(deadline); wait > 0; wait = time.Until(deadline) {
+		resp, err := api.DownloadWait(tools.BaseAPIParams(), id, min(wait, 30*time.Second))
+		if err != nil {
+			// (not yet known to all targets, or a transient error)
+			time.Sleep(min(wait, time.Second))
+			continue
 		}
-		if total == 5*time.Second {
-			tlog.Logfln("still waiting for download [%s] to finish", what)
-			sleep *= 2
+		aborted = resp.Job.Aborted
+		what = resp.Job.String()
+		if l := len(resp.Errs); l > 0 {
+			what = fmt.Sprintf("%s (errs: %v)", resp.Job.String(), resp.Errs[:min(2, l)])
 		}
-		if total >= timeout && !aborted {
-			t.Errorf("timed out waiting %v for download [%s] to finish", timeout, what)
+		if resp.JobFinished() {
+			return
 		}
+		tlog.Logfln("still waiting for download [%s] to finish", what)
+	}
+	if !aborted {
+		t.Errorf("timed out waiting %v for download [%s] to finish", timeout, what)
 	}
 }
 
@@ -680,6 +672,38 @@ func TestDownloadStatus(t *testing.T) {
 	checkDownloadList(t)
 }
 
+func TestDownloadSummary(t *testing.T) {
+	var (
+		bck = cmn.Bck{
+			Name:     testBucketName,
+			Provider: apc.AIS,
+		}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		files      = map[string]string{
+			"readme": "https://raw.githubusercontent.com/NVIDIA/aistore/main/README.md",
+		}
+	)
+
+	clearDownloadList(t)
+
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	id, err := api.DownloadMulti(baseParams, generateDownloadDesc(), bck, files)
+	tassert.CheckFatal(t, err)
+	waitForDownload(t, id, time.Minute)
+
+	resp, err := api.DownloadStatus(baseParams, id, false /*onlyActive*/)
+	tassert.CheckFatal(t, err)
+	summ, err := api.DownloadSummary(baseParams)
+	tassert.CheckFatal(t, err)
+
+	tassert.Errorf(t, summ.Jobs >= 1 && summ.Finished >= 1, "expected at least one finished job, got %+v", summ)
+	tassert.Errorf(t, summ.FinishedCnt >= resp.FinishedCnt && summ.ErrorCnt >= resp.ErrorCnt,
+		"expected totals to include job %q (%+v), got %+v", id, resp.Job, summ)
+	tassert.Errorf(t, !summ.Paused, "not expecting downloads paused")
+}
+
 func TestDownloadStatusError(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
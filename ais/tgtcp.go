@@ -1508,6 +1508,7 @@ func (t *target) shutdown(action string) {
 	nlog.SetStopping()
 	t.regstate.mu.Unlock()
 
+	drainDownloads()
 	t.Stop(&errNoUnregister{action})
 }
 
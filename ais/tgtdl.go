@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -81,6 +82,15 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err)
 			return
 		}
+		if cos.IsParseBool(query.Get(apc.QparamDryRun)) {
+			est, err := dload.Preflight(bck, dlb)
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+			t.writeJSON(w, r, est, "download-preflight")
+			return
+		}
 
 		xdl, err := renewdl(xid, bck)
 		if err != nil {
@@ -97,18 +107,14 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			nlog.Infoln("Downloading:", dljob.ID())
 		}
 
-		dljob.AddNotif(&dload.NotifDownload{
-			Base: nl.Base{
-				When:     core.UponProgress,
-				Interval: progressInterval,
-				Dsts:     []string{equalIC},
-				F:        t.notifyTerm,
-				P:        t.notifyProgress,
-			},
-		}, dljob)
+		dljob.AddNotif(t.dlNotif(progressInterval), dljob)
 		response, statusCode, respErr = xdl.Download(dljob)
 
 	case http.MethodGet:
+		if r.URL.Path == apc.URLPathDownloadSumm.S {
+			t.writeJSON(w, r, dload.Summary(), "download-summary")
+			return
+		}
 		if _, err := t.parseURL(w, r, apc.URLPathDownload.L, 0, false); err != nil {
 			return
 		}
@@ -130,7 +136,15 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err, http.StatusInternalServerError)
 				return
 			}
-			response, statusCode, respErr = xdl.JobStatus(msg.ID, msg.OnlyActive)
+			if msg.Wait > 0 {
+				// long-poll; return the status either way (terminal or not)
+				ctx, cancel := context.WithTimeout(r.Context(), msg.Wait)
+				if _, err := dload.WaitForTerminal(ctx, msg.ID); err != nil && cmn.Rom.V(4, cos.ModAIS) {
+					nlog.Infoln(t.String(), "download job", msg.ID, "wait:", err)
+				}
+				cancel()
+			}
+			response, statusCode, respErr = xdl.JobStatus(msg.ID, msg.OnlyActive, msg.Inflight)
 		} else {
 			var regex *regexp.Regexp
 			if msg.Regex != "" {
@@ -141,7 +155,11 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 				}
 				regex = rgx
 			}
-			response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive)
+			if msg.Limit > 0 {
+				response, statusCode, respErr = dload.ListJobsPage(regex, msg.OnlyActive, msg.Offset, msg.Limit)
+			} else {
+				response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive)
+			}
 		}
 
 	case http.MethodDelete:
@@ -150,7 +168,7 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		actdelete := items[0]
-		if actdelete != apc.Abort && actdelete != apc.Remove {
+		if actdelete != apc.Abort && actdelete != apc.Remove && actdelete != apc.CancelItem {
 			t.writeErrAct(w, r, actdelete)
 			return
 		}
@@ -172,9 +190,22 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err, http.StatusInternalServerError)
 			return
 		}
-		if actdelete == apc.Abort {
+		switch {
+		case payload.Regex != "" && actdelete == apc.Abort:
+			regex, err := regexp.CompilePOSIX(payload.Regex)
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+			response, statusCode, respErr = xdl.AbortMatching(regex)
+		case payload.Regex != "":
+			t.writeErrf(w, r, "%s: regex is not supported with %q (abort only)", t, actdelete)
+			return
+		case actdelete == apc.Abort:
 			response, statusCode, respErr = xdl.AbortJob(payload.ID)
-		} else { // apc.Remove
+		case actdelete == apc.CancelItem:
+			response, statusCode, respErr = xdl.CancelItem(payload.ID, payload.Item)
+		default: // apc.Remove
 			response, statusCode, respErr = xdl.RemoveJob(payload.ID)
 		}
 
@@ -184,8 +215,46 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, respErr, statusCode, Silent)
 			return
 		}
+	case http.MethodPut:
+		items, err := t.parseURL(w, r, apc.URLPathDownload.L, 1, false)
+		if err != nil {
+			return
+		}
+		switch items[0] {
+		case apc.Pause:
+			dload.PauseAll()
+			return
+		case apc.Resume:
+			dload.ResumeAll()
+			return
+		case apc.Priority:
+		default:
+			t.writeErrAct(w, r, items[0])
+			return
+		}
+		payload := &dload.AdminBody{}
+		if err = cmn.ReadJSON(w, r, payload); err != nil {
+			return
+		}
+		if err = payload.Validate(true /*requireID*/); err != nil {
+			debug.Assert(false)
+			t.writeErr(w, r, err)
+			return
+		}
+		xid := r.URL.Query().Get(apc.QparamUUID)
+		debug.Assertf(cos.IsValidUUID(xid), "%q", xid)
+		xdl, err := renewdl(xid, nil)
+		if err != nil {
+			t.writeErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		response, statusCode, respErr = xdl.SetPriority(payload.ID, payload.Priority)
+		if statusCode == http.StatusNotFound {
+			t.writeErr(w, r, respErr, statusCode, Silent)
+			return
+		}
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
 		return
 	}
 
@@ -201,6 +270,49 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (t *target) dlNotif(progressInterval time.Duration) *dload.NotifDownload {
+	return &dload.NotifDownload{
+		Base: nl.Base{
+			When:     core.UponProgress,
+			Interval: progressInterval,
+			Dsts:     []string{equalIC},
+			F:        t.notifyTerm,
+			P:        t.notifyProgress,
+		},
+	}
+}
+
+// upon restart: resume download jobs that were neither finished nor aborted
+// when this target went down (see dload.PendingJobs)
+func (t *target) resumeDownloads() {
+	for _, rec := range dload.PendingJobs() {
+		if err := t.resumeDownload(rec); err != nil {
+			nlog.Errorln(t.String(), "failed to resume download job", rec.ID+":", err)
+		}
+	}
+}
+
+func (t *target) resumeDownload(rec *dload.JobRec) error {
+	bck := meta.CloneBck(&rec.Bck)
+	if err := bck.Init(t.Bowner()); err != nil {
+		dload.DropJob(rec)
+		return err
+	}
+	xdl, err := renewdl(rec.Xid, bck)
+	if err != nil {
+		return err
+	}
+	dljob, err := dload.ResumeJob(bck, rec, xdl, t.dlNotif(dload.DownloadProgressInterval))
+	if err != nil {
+		return err
+	}
+	_, status, err := xdl.Download(dljob)
+	if err == nil && status >= http.StatusBadRequest {
+		err = fmt.Errorf("status %d", status)
+	}
+	return err
+}
+
 func renewdl(xid string, bck *meta.Bck) (*dload.Xact, error) {
 	rns := xreg.RenewDownloader(xid, bck)
 	if rns.Err != nil {
@@ -209,3 +321,21 @@ func renewdl(xid string, bck *meta.Bck) (*dload.Xact, error) {
 	xctn := rns.Entry.Get()
 	return xctn.(*dload.Xact), nil
 }
+
+// graceful shutdown: let in-flight downloads complete (bounded),
+// mark the rest as interrupted
+func drainDownloads() {
+	entry := xreg.GetRunning(&xreg.Flt{Kind: apc.ActDownload})
+	if entry == nil {
+		return
+	}
+	xdl, ok := entry.Get().(*dload.Xact)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cmn.Rom.MaxKeepalive())
+	if err := xdl.Shutdown(ctx); err != nil {
+		nlog.Warningln("downloader shutdown:", err)
+	}
+	cancel()
+}
@@ -90,6 +90,9 @@ func (goi *getOI) _cleanup(revert string, lmfh io.Closer, buf []byte, slab *mems
 // NOTE:
 // Streaming cold GET feature (`feat.StreamingColdGET`) puts response header on the wire _prior_
 // to finalizing in-cluster object. Use it at your own risk.
+// With `feat.VerifyStreamingColdGET` the source checksum (if provided by the backend) gets computed inline
+// and validated at the end of the stream; by then, the content is already transmitted - the mismatch
+// fails the GET (logged and counted) and removes in-cluster object.
 // (under wlock)
 func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 	var (
@@ -116,9 +119,20 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 		written   int64
 		buf, slab = t.gmm.AllocSize(_txsize(res.Size))
 		cksum     = cos.NewCksumHash(lom.CksumConf().Type)
-		mw        = cos.NewWriterMulti(goi.w, lmfh, cksum.H)
+		writers   = make([]io.Writer, 0, 4)
 		whdr      = goi.w.Header()
+		vcksum    *cos.CksumHash
 	)
+	writers = append(writers, goi.w, lmfh, cksum.H)
+	if lom.IsFeatureSet(feat.VerifyStreamingColdGET) && !cos.NoneC(res.ExpCksum) {
+		if res.ExpCksum.Type() == cksum.Type() {
+			vcksum = cksum
+		} else {
+			vcksum = cos.NewCksumHash(res.ExpCksum.Type())
+			writers = append(writers, vcksum.H)
+		}
+	}
+	mw := cos.NewWriterMulti(writers...)
 
 	// response header
 	whdr.Set(cos.HdrContentType, cos.ContentBinary)
@@ -142,6 +156,20 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 		return errTx
 	}
 
+	cksum.Finalize()
+	if vcksum != nil {
+		if vcksum != cksum {
+			vcksum.Finalize()
+		}
+		if !vcksum.Equal(res.ExpCksum) {
+			const act = "(verify)"
+			err = cos.NewErrDataCksum(res.ExpCksum, &vcksum.Cksum, lom.Cname())
+			nlog.Errorln(ftcg, act, err)
+			goi._cleanup(revert, lmfh, buf, slab, err, act)
+			return newErrGetTxSevere(err, lom, act)
+		}
+	}
+
 	if lom.IsFeatureSet(feat.FsyncPUT) {
 		// fsync (flush)
 		if err = lmfh.Sync(); err != nil {
@@ -163,7 +191,6 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 
 	// lom (main replica)
 	lom.SetSize(written)
-	cksum.Finalize()
 	lom.SetCksum(&cksum.Cksum)
 	if lom.HasCopies() {
 		if err := lom.DelAllCopies(); err != nil {
@@ -19,6 +19,8 @@ const (
 	QparamUUID  = "uuid"  // Transaction/xaction UUID identifier
 	QparamJobID = "jobid" // Job identifier
 
+	QparamDryRun = "dry_run" // e.g., download: estimate the number of objects and total size (see dload.Preflight)
+
 	// etl
 	QparamETLName          = "etl_name"
 	QparamETLPipeline      = "etl_pipeline"
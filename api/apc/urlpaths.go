@@ -82,6 +82,11 @@ const (
 	FinishedAck = "finished_ack"
 	UList       = "list"
 	Remove      = "remove"
+	CancelItem  = "cancel-item" // downloader: drop individual item(s) of a running job
+	Priority    = "priority"    // downloader: change priority of a running job
+	Pause       = "pause"       // downloader: pause all downloads (global)
+	Resume      = "resume"      // downloader: resume all downloads (global)
+	DlSummary   = "summary"     // downloader: cluster-wide totals across all jobs
 
 	LoadX509 = "load-x509"
 
@@ -205,6 +210,11 @@ var (
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
 	URLPathDownloadRemove = urlpath(Version, Download, Remove)
+	URLPathDownloadCancel = urlpath(Version, Download, CancelItem)
+	URLPathDownloadPrio   = urlpath(Version, Download, Priority)
+	URLPathDownloadPause  = urlpath(Version, Download, Pause)
+	URLPathDownloadResume = urlpath(Version, Download, Resume)
+	URLPathDownloadSumm   = urlpath(Version, Download, DlSummary)
 
 	URLPathETL       = urlpath(Version, ETL)
 	URLPathETLObject = urlpath(Version, ETL, ETLObject)
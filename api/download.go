@@ -6,6 +6,7 @@ package api
 
 import (
 	"net/http"
+	"net/url"
 	"sort"
 	"time"
 
@@ -56,6 +57,44 @@ func DownloadWithParam(bp BaseParams, dlt dload.Type, body any) (id string, err
 	return
 }
 
+// dry-run: estimate the number of objects and total size without downloading (see dload.Preflight)
+func DownloadPreflight(bp BaseParams, dlt dload.Type, body any) (*dload.Estimate, error) {
+	bp.Method = http.MethodPost
+	msg := cos.MustMarshal(body)
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dload.Body{Type: dlt, RawMessage: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = url.Values{apc.QparamDryRun: []string{"true"}}
+	}
+	est := &dload.Estimate{}
+	_, err := reqParams.DoReqAny(est)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return est, nil
+}
+
+// cluster-wide totals across all download jobs (see dload.DlAggregate)
+func DownloadSummary(bp BaseParams) (*dload.DlAggregate, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadSumm.S
+	}
+	a := &dload.DlAggregate{}
+	_, err := reqParams.DoReqAny(a)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
 func DownloadMulti(bp BaseParams, description string, bck cmn.Bck, msg any, intervals ...time.Duration) (string, error) {
 	dlBody := dload.MultiBody{}
 	if len(intervals) > 0 {
@@ -94,6 +133,46 @@ func DownloadStatus(bp BaseParams, id string, onlyActive bool) (dlStatus *dload.
 	return
 }
 
+// DownloadWait blocks until the job is finished (or aborted) on all targets, or until `wait`
+// elapses, whichever comes first - without polling (see dload.WaitForTerminal);
+// returns the job's status either way (max wait: dload.MaxStatusWait)
+func DownloadWait(bp BaseParams, id string, wait time.Duration) (dlStatus *dload.StatusResp, err error) {
+	dlBody := dload.AdminBody{ID: id, OnlyActive: true, Wait: wait}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+
+	dlStatus = &dload.StatusResp{}
+	_, err = reqParams.DoReqAny(dlStatus)
+	FreeRp(reqParams)
+	return
+}
+
+// currently running (dispatched but not yet finished) items, across all targets
+func DownloadInflight(bp BaseParams, id string) ([]dload.DlItemStatus, error) {
+	dlBody := dload.AdminBody{ID: id, OnlyActive: true, Inflight: true}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	dlStatus := &dload.StatusResp{}
+	_, err := reqParams.DoReqAny(dlStatus)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return dlStatus.Inflight, nil
+}
+
 func DownloadGetList(bp BaseParams, regex string, onlyActive bool) (dlList dload.JobInfos, err error) {
 	dlBody := dload.AdminBody{Regex: regex, OnlyActive: onlyActive}
 	bp.Method = http.MethodGet
@@ -110,6 +189,24 @@ func DownloadGetList(bp BaseParams, regex string, onlyActive bool) (dlList dload
 	return
 }
 
+// DownloadGetPage returns up to `limit` jobs (sorted by start time) starting at `offset`,
+// along with the total number of matching jobs
+func DownloadGetPage(bp BaseParams, regex string, onlyActive bool, offset, limit int) (*dload.JobPage, error) {
+	dlBody := dload.AdminBody{Regex: regex, OnlyActive: onlyActive, Offset: offset, Limit: limit}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	page := &dload.JobPage{}
+	_, err := reqParams.DoReqAny(page)
+	FreeRp(reqParams)
+	return page, err
+}
+
 func AbortDownload(bp BaseParams, id string) error {
 	dlBody := dload.AdminBody{ID: id}
 	bp.Method = http.MethodDelete
@@ -125,6 +222,90 @@ func AbortDownload(bp BaseParams, id string) error {
 	return err
 }
 
+// AbortDownloadMatching aborts all running download jobs with descriptions matching
+// the (POSIX) regex; returns the number of aborted jobs
+func AbortDownloadMatching(bp BaseParams, regex string) (int, error) {
+	var (
+		resp   dload.AbortResp
+		dlBody = dload.AdminBody{Regex: regex}
+	)
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadAbort.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err := reqParams.DoReqAny(&resp)
+	FreeRp(reqParams)
+	return resp.Aborted, err
+}
+
+// CancelDownloadItem drops an individual item (destination object name or source link)
+// of a running download job while the rest of the job proceeds; returns the number
+// of cancelled queued and in-flight items (not-yet-dispatched ones get dropped upon dispatch)
+func CancelDownloadItem(bp BaseParams, id, item string) (int, error) {
+	var (
+		resp   dload.CancelItemResp
+		dlBody = dload.AdminBody{ID: id, Item: item}
+	)
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadCancel.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err := reqParams.DoReqAny(&resp)
+	FreeRp(reqParams)
+	return resp.Cancelled, err
+}
+
+// SetDownloadPriority changes the priority of a running download job;
+// higher-priority jobs get dispatched first (see dload.Base.Priority)
+func SetDownloadPriority(bp BaseParams, id string, priority int) error {
+	dlBody := dload.AdminBody{ID: id, Priority: priority}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadPrio.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// PauseDownloads freezes all downloads cluster-wide without aborting any jobs:
+// nothing new gets dispatched, in-progress items are allowed to finish
+// (see dload.PauseAll); job status shows `paused`
+func PauseDownloads(bp BaseParams) error {
+	return dlGlobal(bp, apc.URLPathDownloadPause.S)
+}
+
+// ResumeDownloads continues all downloads from where they were paused
+func ResumeDownloads(bp BaseParams) error {
+	return dlGlobal(bp, apc.URLPathDownloadResume.S)
+}
+
+func dlGlobal(bp BaseParams, path string) error {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+		reqParams.Body = cos.MustMarshal(dload.AdminBody{})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 func RemoveDownload(bp BaseParams, id string) error {
 	dlBody := dload.AdminBody{ID: id}
 	bp.Method = http.MethodDelete
@@ -57,6 +57,12 @@ const (
 	AisK8sPublicDNSMode        = "AIS_PUBLIC_DNS_MODE"
 	AisK8sEnableExternalAccess = "ENABLE_EXTERNAL_ACCESS"
 
+	// downloader: kvdb namespace to segregate persisted job records (multi-tenant);
+	// not set: legacy (un-prefixed) layout
+	AisDloadNamespace = "AIS_DLOAD_NAMESPACE"
+	// downloader: number of concurrent download workers per mountpath (default: 1)
+	AisDloadWorkers = "AIS_DLOAD_WORKERS"
+
 	// AisK8sPublicDNSMode values
 	PubNetDNSModeIP   string = "IP"
 	PubNetDNSModeNode string = "Node"
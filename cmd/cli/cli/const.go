@@ -430,6 +430,9 @@ const (
 	// 'ais ls'
 	lsAnyCommandArgument = bucketEmbeddedPrefixArg + " [PROVIDER]"
 
+	// 'ais scrub'
+	scrubArgument = bucketEmbeddedPrefixArg + " [BUCKET|PROVIDER ...]"
+
 	// Auth
 	userLoginArgument = "USER_NAME"
 	userPassArgument  = "USER_PASS"
@@ -675,7 +678,215 @@ var (
 	}
 	largeSizeFlag = cli.StringFlag{
 		Name:  "large-size",
-		Usage: "Count and report all objects that are larger or equal in size  (e.g.: 4mb, 1MiB, 1048576, 128k; default: 5 GiB)",
+		Usage: "Count and report all objects that are larger or equal in size (e.g.: 4mb, 1MiB, 1048576, 128k; default: 5 GiB)",
+	}
+
+	scrubNamePolicyFlag = cli.StringFlag{
+		Name: "name-policy",
+		Usage: "Regular expression that all object names must match; count and report names that don't, e.g.:\n" +
+			indent4 + "\t--name-policy '^[a-z0-9-/]+$'",
+	}
+	scrubOutFileFlag = cli.StringFlag{
+		Name:  "out-file",
+		Usage: "In addition to per-metric detailed logs, write all flagged objects to a single CSV file (\"Issue,Name,Size\")",
+	}
+	scrubStreamFlag = cli.BoolFlag{
+		Name: "stream",
+		Usage: "Emit flagged objects to standard output as they are found, one JSON line per object (NDJSON):\n" +
+			indent4 + "\t{\"bucket\": ..., \"name\": ..., \"issue\": ..., \"size\": ...};\n" +
+			indent4 + "\tprogress and the final summary go to standard error, e.g.:\n" +
+			indent4 + "\t'ais scrub s3://abc --stream 2>/dev/null | jq -r .name'",
+	}
+
+	scrubAsyncFlag = cli.BoolFlag{
+		Name: "async",
+		Usage: "Run in the background (detached) and return job ID right away;\n" +
+			indent4 + "\tto monitor progress and, once done, show the results, run 'ais show job <job-ID>'",
+	}
+	scrubOnlyZeroFlag = cli.BoolFlag{
+		Name: "only-zero-size",
+		Usage: "Record the names of zero-size objects and list them (per bucket) upon completion;\n" +
+			indent4 + "\tthe number of names recorded per bucket is limited by '--max-names'",
+	}
+	scrubMaxNamesFlag = cli.IntFlag{
+		Name:  "max-names",
+		Usage: "Maximum number of zero-size object names to record per bucket (see '--only-zero-size')",
+		Value: scrMaxNamesDflt,
+	}
+
+	scrubOutputFlag = cli.StringFlag{
+		Name: "output",
+		Usage: "Print per-bucket results in a structured format: 'json' or 'csv' (one record per bucket), whereby\n" +
+			indent4 + "\tJSON is the same as '--json' (and can be used with '--compare-to'), and\n" +
+			indent4 + "\tCSV has a fixed header row (unless '--no-headers'); progress and notes go to standard error, e.g.:\n" +
+			indent4 + "\t'ais scrub s3:// --output=csv 2>/dev/null > scrub.csv'",
+	}
+
+	scrubTUIFlag = cli.BoolFlag{
+		Name: "tui",
+		Usage: "Full-screen live view: per-bucket progress and counters updated in place;\n" +
+			indent4 + "\tkeys: 's' - sort (by bucket, names, or issues), 'c' - show/hide clean buckets,\n" +
+			indent4 + "\t'↑'/'↓' - select, 'enter' - the selected bucket's (most recent) flagged objects, 'q' - quit;\n" +
+			indent4 + "\tonce done, press 'q' to print the regular results; ignored when not running in a terminal",
+	}
+
+	scrubDeepFlag = cli.BoolFlag{
+		Name: "deep",
+		Usage: "For in-cluster objects: load stored metadata and compare it with listed size, checksum, and version\n" +
+			indent4 + "\t(expensive: one HEAD request per object; consider using together with '--limit' and/or '--max-pages')",
+	}
+	scrubMaxListRateFlag = cli.IntFlag{
+		Name: "max-list-rate",
+		Usage: "Throttle listing to at most the specified number of objects per second, per bucket\n" +
+			indent4 + "\t(to keep scrubbing in the background and not impact production traffic)",
+	}
+	scrubMaxListRateTotalFlag = cli.IntFlag{
+		Name:  "max-list-rate-total",
+		Usage: "Same as '--max-list-rate' but applies to all buckets combined (can be used together with the former)",
+	}
+	scrubConfirmThresholdFlag = cli.IntFlag{
+		Name: "confirm-threshold",
+		Usage: "When the remediation script (see '--emit-script') would remove, or '--prefetch' would fetch, more than the specified\n" +
+			indent4 + "\tnumber of objects, print the count and ask for confirmation prior to proceeding (or use '--yes');\n" +
+			indent4 + "\tprotects against a misconfigured prefix (or bucket) selecting everything",
+	}
+	scrubPrefetchFlag = cli.BoolFlag{
+		Name: "prefetch",
+		Usage: "Upon completion, prefetch remote objects that are not present in the cluster (the 'NOT-CACHED' column)\n" +
+			indent4 + "\tby starting prefetch job(s) - one per bucket; see also '--confirm-threshold'",
+	}
+	scrubPrefetchPagesFlag = cli.IntFlag{
+		Name: "prefetch-pages",
+		Usage: "List up to N pages ahead while processing the current one (N=1: double-buffering);\n" +
+			indent4 + "\tmay speed up scrubbing large buckets (default: list pages sequentially)",
+	}
+	scrubNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "Number of concurrent (client-side) requests to execute '--deep' and '--schema' verification;\n" +
+			indent4 + "\tdefaults to the number of CPUs if omitted or zero; use 1 for serial execution",
+	}
+	scrubSchemaFlag = cli.StringFlag{
+		Name: "schema",
+		Usage: "For in-cluster objects: read each object and validate its (JSON) content against the specified JSON Schema file;\n" +
+			indent4 + "\tcount and list invalid objects (the first few - with reasons); supported keywords: type, enum, const,\n" +
+			indent4 + "\trequired, properties, additionalProperties, items, min/maxItems, min/maxLength, minimum/maximum, pattern\n" +
+			indent4 + "\t(expensive: one GET per object; consider using together with '--sample', '--limit', and/or '--max-pages')",
+	}
+	scrubSampleFlag = cli.IntFlag{
+		Name:  "sample",
+		Usage: "With '--schema': validate every N-th in-cluster object (default: all)",
+	}
+	scrubByLocationFlag = cli.BoolFlag{
+		Name: "by-location",
+		Usage: "Additionally, group misplaced and missing-copies objects by their respective location (target:mountpath);\n" +
+			indent4 + "\tlabel locations on disabled, detached, or degraded mountpaths (and unavailable targets), if any",
+	}
+	scrubEmitScriptFlag = cli.StringFlag{
+		Name: "emit-script",
+		Usage: "Write remediation commands (one 'ais' command per line) into the specified shell script for review;\n" +
+			indent4 + "\tcovers misplaced objects (rebalance, resilver), missing copies (mirror), and zero-size objects (rm)",
+	}
+	scrubTemplateFlag = cli.StringFlag{
+		Name: "template",
+		Usage: "Print results using named template (e.g., 'scrub-brief') or Go template from the specified file;\n" +
+			indent4 + "\tthe template is executed against the list of per-bucket results (see teb.ScrBp)",
+	}
+	scrubCompareToFlag = cli.StringFlag{
+		Name: "compare-to",
+		Usage: "Compare with a previously saved scrub result and show per-bucket deltas, e.g.:\n" +
+			indent4 + "\t'ais scrub s3://abc --json > /tmp/scrub.json' (or '--output=json') and later:\n" +
+			indent4 + "\t'ais scrub s3://abc --compare-to /tmp/scrub.json'",
+	}
+	scrubHistoryBucketFlag = cli.StringFlag{
+		Name: "history-bucket",
+		Usage: "Upon completion, store the run's summary (along with cluster UUID and scrub parameters)\n" +
+			indent4 + "\tin the specified bucket, one timestamped object per run, e.g.:\n" +
+			indent4 + "\t'ais scrub s3://abc --history-bucket ais://scrub-log';\n" +
+			indent4 + "\tunless " + qflprn(scrubCompareToFlag) + " is specified, the run is compared with the most recent stored summary (if any)",
+	}
+	scrubFindDupesFlag = cli.BoolFlag{
+		Name: "find-dupes",
+		Usage: "Find duplicate content: distinct names sharing the same checksum (and size);\n" +
+			indent4 + "\tcount duplicates and list duplicate groups in a detailed log (and '--out-file', if specified);\n" +
+			indent4 + "\tnote: in-cluster objects only; memory-bound - tracks up to 1M distinct checksums",
+	}
+	scrubNoCksumFlag = cli.BoolFlag{
+		Name: "find-no-cksum",
+		Usage: "Count (and list) in-cluster objects that have no checksum - e.g., written while checksumming was disabled\n" +
+			indent4 + "\t(candidates for a re-checksum pass); offenders are also written to '--out-file', if specified",
+	}
+	scrubFailFastFlag = cli.StringFlag{
+		Name: "fail-fast",
+		Usage: "Pass/fail gate: stop scrubbing a bucket as soon as any of the specified counters exceeds its limit\n" +
+			indent4 + "\t(results are marked partial, and the command fails), e.g.:\n" +
+			indent4 + "\t  --fail-fast misplaced=100\n" +
+			indent4 + "\t  --fail-fast 'missing-copies=0,not-cached=1000'\n" +
+			indent4 + "\t(names: lowercase column names, or 'misplaced' - cluster and mountpath combined)",
+	}
+	scrubFailOnFlag = cli.StringFlag{
+		Name: "fail-on",
+		Usage: "Pass/fail gate: upon completion, fail (exit status 1) if any of the specified counters is non-zero\n" +
+			indent4 + "\tin any of the scrubbed buckets, e.g.:\n" +
+			indent4 + "\t  --fail-on misplaced,missing-copies\n" +
+			indent4 + "\t  --fail-on 'not-cached,zero-size'\n" +
+			indent4 + "\t(names: as in '--fail-fast', plus 'zero-size')",
+	}
+	scrubOnIssueFlag = cli.StringFlag{
+		Name: "on-issue",
+		Usage: "Run the specified command once, at the end, if any bucket has issues (anything other than the number of objects,\n" +
+			indent4 + "\tnot-cached, large, and excluded); the command receives per-bucket results (JSON, same as '--json') via stdin\n" +
+			indent4 + "\tand fails 'ais scrub' with its non-zero exit code, e.g.:\n" +
+			indent4 + "\t  --on-issue '/usr/local/bin/notify-oncall --source scrub'\n" +
+			indent4 + "\t(no shell: the command and its arguments are separated by whitespace, and passed as is)",
+	}
+	scrubEmptyDirsFlag = cli.BoolFlag{
+		Name: "report-empty-dirs",
+		Usage: "Count (and list) empty virtual directories: directory entries (e.g., 'dir/' markers left behind by mass deletes)\n" +
+			indent4 + "\twith no objects underneath, at any depth; offenders are also written to '--out-file', if specified",
+	}
+	scrubWideFlag = cli.BoolFlag{
+		Name: "wide",
+		Usage: "Fixed layout: show every counter as its own column, including all-zero and remote-only columns\n" +
+			indent4 + "\t(implies '--all-columns'; opt-in counters still require their respective options)",
+	}
+	scrubSortFlag = cli.StringFlag{
+		Name: "sort",
+		Usage: "Multi-bucket: sort results by bucket name or by any counter (lowercase column name), e.g.:\n" +
+			indent4 + "\t  --sort misplaced\t- misplaced (cluster and mountpath combined), largest first\n" +
+			indent4 + "\t  --sort 'missing-copies:asc'\n" +
+			indent4 + "\t  --sort bucket\t\t- by name (counters: descending, bucket: ascending, unless ':asc' or ':desc')",
+	}
+	scrubSubtotalsFlag = cli.BoolFlag{
+		Name: "subtotals",
+		Usage: "Multi-bucket: group results by (bucket provider, namespace) and follow each group with a subtotal row,\n" +
+			indent4 + "\te.g., to attribute issues to a given backend or tenant (subtotal's elapsed time: the longest in the group)",
+	}
+	scrubVerifyCopiesFlag = cli.BoolFlag{
+		Name: "verify-copies",
+		Usage: "Mirrored buckets: compare checksums (and sizes) of all replicas of each object, as stored with each replica;\n" +
+			indent4 + "\tcount (and list) objects whose copies disagree (e.g., a stale copy that missed an update);\n" +
+			indent4 + "\tnote: per-replica metadata comes with the listing (no extra requests), which makes the listing itself heavier",
+	}
+	scrubStatusFileFlag = cli.StringFlag{
+		Name: "status-file",
+		Usage: "Periodically (see '--refresh') write current progress to the specified file, for monitoring by another process;\n" +
+			indent4 + "\tJSON snapshot of per-bucket counters, listed names, and continuation tokens - replaced atomically, final upon completion",
+	}
+	scrubTopFlag = cli.IntFlag{
+		Name: "top",
+		Usage: "Report the N largest objects (name and size) encountered while scrubbing;\n" +
+			indent4 + "\twhen '--out-file' is specified, the list is written there as well",
+	}
+	scrubPendingDelFlag = cli.BoolFlag{
+		Name: "pending-delete",
+		Usage: "Count objects that are listed but pending deletion: deleted remotely but still in-cluster,\n" +
+			indent4 + "\tor leftover copies with the main replica missing (requires additional per-object status;\n" +
+			indent4 + "\tfor remote buckets, also implies comparing in-cluster and remote metadata)",
+	}
+	scrubExcludePrefixFlag = cli.StringSliceFlag{
+		Name: "exclude-prefix",
+		Usage: "Skip objects with names starting with the specified prefix (repeatable, or comma-separated);\n" +
+			indent4 + "\te.g.: '--exclude-prefix tmp/ --exclude-prefix _staging/' (excluded objects are counted separately)",
 	}
 
 	// alternative cleanup via global rebalance (running rebalance in "cleanup" mode)
@@ -821,6 +1032,13 @@ var (
 		Value: dload.DownloadProgressInterval,
 	}
 
+	cancelItemFlag = cli.StringFlag{
+		Name: "cancel-item",
+		Usage: "Comma-separated list of items (destination object names or source links) to cancel;\n" +
+			indent4 + "\tthe rest of the download job proceeds, e.g.:\n" +
+			indent4 + "\t'ais stop download nZzA8 --cancel-item obj1,obj2'",
+	}
+
 	limitConnectionsFlag = cli.IntFlag{
 		Name:  "max-conns",
 		Usage: "Maximum number of connections each target can make concurrently (up to num mountpaths)",
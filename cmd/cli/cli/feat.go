@@ -52,6 +52,10 @@ var clusterFeatDesc = [...]string{
 	"count GET(object) 404 as errors (default: don't)",
 	"publish selected Go runtime metrics via Prometheus",
 	"allow downloader egress to private RFC1918/ULA addresses; loopback and link-local remain blocked",
+	"when failing to decode persisted metadata, include tag, byte offset, and detected version(s)",
+	"when atomically replacing persisted metadata: fsync the file prior to rename, and the directory after",
+	"S3 multipart: reverse-proxy part uploads to designated targets instead of HTTP-redirecting",
+	"with Streaming-Cold-GET: compute source checksum inline and validate it at the end of the stream",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -85,6 +89,10 @@ var featTags = map[string]string{
 	"Count-Object-NotFound-Stats":          "telemetry,ops",
 	"Enable-Go-Runtime-Metrics":            "telemetry,ops,overhead",
 	"Dload-Allow-Private-Egress":           "security-",
+	"Verbose-Meta-Errors":                  "integrity,ops",
+	"Fsync-Meta":                           "integrity+,overhead",
+	"S3-MPU-Reverse-Proxy":                 "s3,mpu,net,compat",
+	"Verify-Streaming-Cold-GET":            "integrity+,overhead",
 }
 
 // common (cluster, bucket) feature-flags (set, show) helper
@@ -89,6 +89,7 @@ const stopUsage = "Terminate a single batch job or multiple jobs, e.g.:\n" +
 	indent1 + "\t- 'stop prefetch-listrange'\t- stop all prefetch jobs;\n" +
 	indent1 + "\t- 'stop prefetch'\t- same as above;\n" +
 	indent1 + "\t- 'stop g731 --force'\t- forcefully abort global rebalance g731 (advanced usage only);\n" +
+	indent1 + "\t- 'stop download nZzA8 --cancel-item obj1,obj2'\t- cancel given items of download job nZzA8 (the rest of the job proceeds);\n" +
 	indent1 + "\t- 'stop --all'\t- terminate all running jobs\n" +
 	indent1 + tabHelpOpt + "."
 
@@ -261,6 +262,7 @@ var (
 		regexJobsFlag,
 		forceFlag,
 		yesFlag,
+		cancelItemFlag,
 	}
 	jobStopSub = cli.Command{
 		Name:         commandStop,
@@ -1160,6 +1162,13 @@ func stopJobHandler(c *cli.Context) error {
 	if name == "" && xid == "" && !flagIsSet(c, allRunningJobsFlag) {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	if flagIsSet(c, cancelItemFlag) {
+		if name != cmdDownload || xid == "" {
+			return incorrectUsageMsg(c, "option %s requires download job ID, e.g.: 'stop %s %s %s ITEM'",
+				qflprn(cancelItemFlag), cmdDownload, jobIDArgument, flprn(cancelItemFlag))
+		}
+		return cancelDownloadItems(c, xid)
+	}
 	if daemonID != "" {
 		warn := fmt.Sprintf("node ID %q will be ignored (stopping job on a given node not supported)\n",
 			daemonID)
@@ -1382,6 +1391,23 @@ func stopDownloadHandler(c *cli.Context, id string) (err error) {
 	return
 }
 
+// cancel individual items (destination object names or source links) of a running download job
+func cancelDownloadItems(c *cli.Context, id string) error {
+	var cnt int
+	for _, item := range splitCsv(parseStrFlag(c, cancelItemFlag)) {
+		if item == "" {
+			continue
+		}
+		n, err := api.CancelDownloadItem(apiBP, id, item)
+		if err != nil {
+			return err
+		}
+		cnt += n
+	}
+	actionDonef(c, "Cancelled %d item%s of download job %s\n", cnt, cos.Plural(cnt), id)
+	return nil
+}
+
 //nolint:dupl // stop downloads and dsorts: different API methods justify seemingly duplicated code
 func stopDsortRegex(c *cli.Context, regex string) error {
 	dsortLst, err := api.ListDsort(apiBP, regex, true /*onlyActive*/)
@@ -0,0 +1,68 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+func newCancelItemCtx(t *testing.T, out io.Writer, args ...string) *cli.Context {
+	app := cli.NewApp()
+	app.Writer, app.ErrWriter = out, io.Discard
+	set := flag.NewFlagSet(commandStop, flag.ContinueOnError)
+	cancelItemFlag.Apply(set)
+	tassert.CheckFatal(t, set.Parse(args))
+	return cli.NewContext(app, set, nil)
+}
+
+func TestCancelDownloadItems(t *testing.T) {
+	var items []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body dload.AdminBody
+		if r.Method != http.MethodDelete || r.URL.Path != apc.URLPathDownloadCancel.S {
+			http.Error(w, "unexpected "+r.Method+" "+r.URL.Path, http.StatusBadRequest)
+			return
+		}
+		if err := jsoniter.NewDecoder(r.Body).Decode(&body); err != nil || body.ID != "nZzA8" {
+			http.Error(w, "download job not found", http.StatusNotFound)
+			return
+		}
+		items = append(items, body.Item)
+		resp := dload.CancelItemResp{}
+		if body.Item != "later" {
+			resp.Cancelled = 1
+		}
+		w.Header().Set("Content-Type", "application/json")
+		jsoniter.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	saved := apiBP
+	apiBP = api.BaseParams{URL: srv.URL, Client: srv.Client()}
+	defer func() { apiBP = saved }()
+
+	var out bytes.Buffer
+	c := newCancelItemCtx(t, &out, "--cancel-item", "obj1, http://host/obj2,,later")
+	tassert.CheckFatal(t, cancelDownloadItems(c, "nZzA8"))
+	tassert.Errorf(t, strings.Join(items, " ") == "obj1 http://host/obj2 later", "unexpected items %q", items)
+	tassert.Errorf(t, strings.Contains(out.String(), "Cancelled 2 items of download job nZzA8"), "unexpected output %q", out.String())
+
+	err := cancelDownloadItems(newCancelItemCtx(t, io.Discard, "--cancel-item", "obj1"), "no-such-job")
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "not found"), "expecting not-found error, got %v", err)
+}
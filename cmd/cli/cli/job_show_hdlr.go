@@ -132,6 +132,11 @@ func showJobsHandler(c *cli.Context) error {
 		}
 	}
 
+	// (see scrub '--async')
+	if id := c.Args().Get(0); isScrJobID(id) {
+		return showScrubJob(c, id)
+	}
+
 	var (
 		multimatch                    bool
 		l                             int
@@ -5,13 +5,22 @@
 package cli
 
 import (
+	"bytes"
+	"container/heap"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -21,9 +30,14 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -31,8 +45,11 @@ import (
 // - '--checksum' option (slow)
 // - '--fix' option (***)
 // - multiple buckets vs one-log-per-scrub-metric - a problem
-// - async execution with '--wait' option
+// - '--async': '--wait' option
 // - speed-up `ls` via multiple workers
+// - '--audit-retention' (WORM buckets): blocked on object retention (lock) metadata - AIS buckets and
+//   objects do not carry any (no retain-until, no legal hold); read-only access (see apc.AccessRO)
+//   is not a substitute
 
 const (
 	logFname           = ".ais-scrub-%s.%x.log"
@@ -40,9 +57,13 @@ const (
 	logTitleVerChanged = "Name,Size,Custom"
 	logTitleMisplaced  = "Name,Size,Atime,Location"
 	logTitleCopies     = "Name,Size,Copies"
+	logTitleOutFile    = "Issue,Name,Size"
+	logTitleDupes      = "Checksum,Name,Size"
 	logDelim           = `","`
 
 	logMaxLn = 256
+
+	scrLargeDflt = 5 * cos.GiB // (see largeSizeFlag)
 )
 
 type (
@@ -65,6 +86,7 @@ type (
 		c      *cli.Context
 		scrubs []*scrBp
 		qbck   cmn.QueryBcks
+		more   []cmn.QueryBcks // additional buckets and/or providers (positional)
 		pref   string
 		units  string
 		// sizing
@@ -73,14 +95,79 @@ type (
 		// timing
 		ival time.Duration
 		last atomic.Int64
+		// '--max-list-rate' and '--max-list-rate-total'
+		rate      int
+		rateTotal *scrRate
+		// '--prefetch-pages'
+		pagesAhead int
 		// total num listed names
 		total atomic.Int64
+		// name policy (optional)
+		namePolicy *regexp.Regexp
+		// '--deep'
+		deep       bool
+		numWorkers int
+		// '--schema'
+		schema *scrSchema
+		// '--top'
+		top *scrTop
+		// '--only-zero-size' and '--max-names'
+		onlyZero bool
+		maxNames int
+		// '--status-file'
+		status *scrStatus
+		// '--find-no-cksum'
+		noCksum bool
+		// '--fail-fast'
+		failFast *scrFailFast
+		// '--fail-on'
+		failOn scrFailOn
+		// '--on-issue'
+		onIssue []string
+		// '--sort'
+		less func(a, b *scrBp) bool
+		// '--verify-copies'
+		verifyCopies bool
+		// '--report-empty-dirs'
+		emptyDirs bool
+		// '--exclude-prefix'
+		excl []string
+		// '--pending-delete'
+		pendingDel bool
+		// '--find-dupes'
+		dupes *scrDupes
+		// '--prefetch'
+		pf *scrPrefetch
+		// '--history-bucket'
+		hist *scrHist
+		// '--json'
+		jsout bool
+		// '--output' (json or csv)
+		output string
+		// '--stream'
+		stream *scrStream
+		// '--tui'
+		tui *scrTUI
+		// '--template' (registered name)
+		tmpl string
+		// '--emit-script'
+		script _log
+		tids   map[string]struct{} // targets to resilver
+		numRm  int                 // objects to remove (see '--confirm-threshold')
 		// detailed logs
 		logs       [teb.ScrNumStats]_log
+		outf       _log // all of the above in a single file (optional)
 		progLine   cos.SB
 		numBcks    int
 		pid        int
 		haveRemote atomic.Bool
+		// interrupted via SIGINT/SIGTERM
+		stopped atomic.Bool
+		// '--by-location'
+		locs  map[string]*teb.ScrLoc
+		locMu sync.Mutex
+		// buckets that failed to list (multi-bucket), by reason
+		skipped map[string]int
 	}
 )
 
@@ -98,6 +185,44 @@ var (
 		largeSizeFlag,
 		scrubObjCachedFlag,
 		allColumnsFlag,
+		scrubWideFlag,
+		scrubSortFlag,
+		scrubSubtotalsFlag,
+		scrubStatusFileFlag,
+		scrubNamePolicyFlag,
+		scrubOutFileFlag,
+		scrubStreamFlag,
+		scrubOutputFlag,
+		scrubTUIFlag,
+		scrubAsyncFlag,
+		scrubOnlyZeroFlag,
+		scrubMaxNamesFlag,
+		scrubByLocationFlag,
+		scrubDeepFlag,
+		scrubNumWorkersFlag,
+		scrubSchemaFlag,
+		scrubSampleFlag,
+		scrubExcludePrefixFlag,
+		scrubPendingDelFlag,
+		scrubFindDupesFlag,
+		scrubNoCksumFlag,
+		scrubVerifyCopiesFlag,
+		scrubEmptyDirsFlag,
+		scrubTopFlag,
+		scrubFailFastFlag,
+		scrubFailOnFlag,
+		scrubOnIssueFlag,
+		scrubCompareToFlag,
+		scrubHistoryBucketFlag,
+		scrubEmitScriptFlag,
+		scrubConfirmThresholdFlag,
+		scrubPrefetchFlag,
+		scrubPrefetchPagesFlag,
+		scrubMaxListRateFlag,
+		scrubMaxListRateTotalFlag,
+		yesFlag,
+		scrubTemplateFlag,
+		jsonFlag,
 	)
 )
 
@@ -110,10 +235,24 @@ func scrubHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	for _, arg := range c.Args().Tail() {
+		qbck, pref, err := parseQueryBckURI(arg)
+		if err != nil {
+			return err
+		}
+		if pref != "" || ctx.pref != "" {
+			return fmt.Errorf("embedded prefix requires a single bucket argument (use %s to apply the prefix to all)",
+				qflprn(bsummPrefixFlag))
+		}
+		ctx.more = append(ctx.more, qbck)
+	}
 	ctx.units, err = parseUnitsFlag(ctx.c, unitsFlag)
 	if err != nil {
 		return err
 	}
+	if err := errMutuallyExclusive(c, scrubAsyncFlag, scrubTUIFlag, scrubStreamFlag, scrubStatusFileFlag); err != nil {
+		return err
+	}
 
 	// embedded prefix vs '--prefix'
 	prefix := parseStrFlag(c, bsummPrefixFlag)
@@ -150,7 +289,7 @@ func scrubHandler(c *cli.Context) (err error) {
 		return fmt.Errorf("%s (%s) cannot be negative", qflprn(smallSizeFlag), cos.IEC(ctx.small, 0))
 	}
 
-	ctx.large = 5 * cos.GiB
+	ctx.large = scrLargeDflt
 	if flagIsSet(c, largeSizeFlag) {
 		ctx.large, err = parseSizeFlag(c, largeSizeFlag)
 		if err != nil {
@@ -163,66 +302,1278 @@ func scrubHandler(c *cli.Context) (err error) {
 			qflprn(smallSizeFlag), cos.IEC(ctx.small, 0))
 	}
 
-	bcks, errN := ctx.lsBcks()
-	if errN != nil {
-		return V(err)
+	if flagIsSet(c, scrubNamePolicyFlag) {
+		s := parseStrFlag(c, scrubNamePolicyFlag)
+		if ctx.namePolicy, err = regexp.Compile(s); err != nil {
+			return fmt.Errorf("invalid %s %q: %v", qflprn(scrubNamePolicyFlag), s, err)
+		}
+	}
+
+	if flagIsSet(c, scrubExcludePrefixFlag) {
+		for _, s := range c.StringSlice(scrubExcludePrefixFlag.Name) {
+			for p := range strings.SplitSeq(s, ",") {
+				if p = strings.TrimSpace(p); p != "" {
+					ctx.excl = append(ctx.excl, p)
+				}
+			}
+		}
+		if len(ctx.excl) == 0 {
+			return fmt.Errorf("%s: expecting at least one non-empty prefix", qflprn(scrubExcludePrefixFlag))
+		}
+	}
+
+	if flagIsSet(c, scrubTemplateFlag) {
+		if ctx.tmpl, err = scrubTemplate(c); err != nil {
+			return err
+		}
+	}
+
+	bcks, errN := ctx.lsBcks()
+	if errN != nil {
+		return V(errN)
+	}
+
+	ctx.pid = os.Getpid()
+
+	ctx.deep = flagIsSet(c, scrubDeepFlag)
+	if flagIsSet(c, scrubSchemaFlag) {
+		if err := errMutuallyExclusive(c, scrubSchemaFlag, scrubObjCachedFlag); err != nil {
+			return err
+		}
+		sample := parseIntFlag(c, scrubSampleFlag)
+		if sample < 0 {
+			return fmt.Errorf("%s cannot be negative", qflprn(scrubSampleFlag))
+		}
+		fname := parseStrFlag(c, scrubSchemaFlag)
+		if ctx.schema, err = newScrSchema(fname, int64(sample)); err != nil {
+			return fmt.Errorf("%s %q: %v", qflprn(scrubSchemaFlag), fname, err)
+		}
+	} else if flagIsSet(c, scrubSampleFlag) {
+		return fmt.Errorf("%s requires %s", qflprn(scrubSampleFlag), qflprn(scrubSchemaFlag))
+	}
+	if ctx.deep || ctx.schema != nil {
+		if ctx.numWorkers, err = parseNumWorkersFlag(c, scrubNumWorkersFlag); err != nil {
+			return err
+		}
+		if ctx.numWorkers == 0 {
+			ctx.numWorkers = sys.NumCPU()
+		}
+	}
+	ctx.pendingDel = flagIsSet(c, scrubPendingDelFlag)
+	if flagIsSet(c, scrubFindDupesFlag) {
+		ctx.dupes = &scrDupes{m: make(map[string]*scrDupGroup, 1024)}
+	}
+	ctx.noCksum = flagIsSet(c, scrubNoCksumFlag)
+	ctx.verifyCopies = flagIsSet(c, scrubVerifyCopiesFlag)
+	ctx.emptyDirs = flagIsSet(c, scrubEmptyDirsFlag)
+	if flagIsSet(c, scrubFailFastFlag) {
+		if ctx.failFast, err = parseFailFast(parseStrFlag(c, scrubFailFastFlag)); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(scrubFailFastFlag), err)
+		}
+	}
+	if flagIsSet(c, scrubFailOnFlag) {
+		if ctx.failOn, err = parseFailOn(parseStrFlag(c, scrubFailOnFlag)); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(scrubFailOnFlag), err)
+		}
+	}
+	if flagIsSet(c, scrubSortFlag) {
+		if ctx.less, err = parseScrSort(parseStrFlag(c, scrubSortFlag)); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(scrubSortFlag), err)
+		}
+	}
+	if flagIsSet(c, scrubOnIssueFlag) {
+		if ctx.onIssue, err = parseOnIssue(parseStrFlag(c, scrubOnIssueFlag)); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(scrubOnIssueFlag), err)
+		}
+	}
+	if flagIsSet(c, scrubTopFlag) {
+		n := parseIntFlag(c, scrubTopFlag)
+		if n <= 0 || n > scrTopMax {
+			return fmt.Errorf("invalid %s=%d (expecting 1 to %d)", qflprn(scrubTopFlag), n, scrTopMax)
+		}
+		ctx.top = &scrTop{n: n, h: make(scrTopHeap, 0, n)}
+	}
+	if flagIsSet(c, scrubMaxNamesFlag) && !flagIsSet(c, scrubOnlyZeroFlag) {
+		return fmt.Errorf("%s requires %s", qflprn(scrubMaxNamesFlag), qflprn(scrubOnlyZeroFlag))
+	}
+	if ctx.onlyZero = flagIsSet(c, scrubOnlyZeroFlag); ctx.onlyZero {
+		if ctx.maxNames = parseIntFlag(c, scrubMaxNamesFlag); ctx.maxNames <= 0 {
+			return fmt.Errorf("invalid %s=%d (expecting positive integer)", qflprn(scrubMaxNamesFlag), ctx.maxNames)
+		}
+	}
+	if flagIsSet(c, scrubStatusFileFlag) {
+		ctx.status = newScrStatus(parseStrFlag(c, scrubStatusFileFlag))
+		if err := ctx.status.write(&ctx); err != nil {
+			return fmt.Errorf("%s: %v", qflprn(scrubStatusFileFlag), err)
+		}
+	}
+	if flagIsSet(c, scrubPrefetchFlag) {
+		if err := errMutuallyExclusive(c, scrubPrefetchFlag, scrubObjCachedFlag); err != nil {
+			return err
+		}
+		ctx.pf = &scrPrefetch{m: make(map[string]*scrPfBck, 4)}
+	}
+	if ctx.pagesAhead = parseIntFlag(c, scrubPrefetchPagesFlag); ctx.pagesAhead < 0 || ctx.pagesAhead > scrPagesAheadMax {
+		return fmt.Errorf("invalid %s=%d (expecting 0 (disabled) to %d)", qflprn(scrubPrefetchPagesFlag), ctx.pagesAhead, scrPagesAheadMax)
+	}
+	if ctx.rate = parseIntFlag(c, scrubMaxListRateFlag); ctx.rate < 0 {
+		return fmt.Errorf("%s cannot be negative", qflprn(scrubMaxListRateFlag))
+	}
+	if n := parseIntFlag(c, scrubMaxListRateTotalFlag); n > 0 {
+		ctx.rateTotal = newScrRate(n)
+	} else if n < 0 {
+		return fmt.Errorf("%s cannot be negative", qflprn(scrubMaxListRateTotalFlag))
+	}
+	ctx.jsout = flagIsSet(c, jsonFlag)
+	if flagIsSet(c, scrubStreamFlag) {
+		if err := errMutuallyExclusive(c, scrubStreamFlag, jsonFlag); err != nil {
+			return err
+		}
+		ctx.stream = &scrStream{w: c.App.Writer}
+		// everything else => stderr
+		teb.Writer = c.App.ErrWriter
+		defer func() { teb.Writer = c.App.Writer }()
+	}
+
+	if flagIsSet(c, scrubOutputFlag) {
+		if err := errMutuallyExclusive(c, scrubOutputFlag, jsonFlag, scrubStreamFlag, scrubTemplateFlag); err != nil {
+			return err
+		}
+		if ctx.output, err = parseScrOutput(parseStrFlag(c, scrubOutputFlag)); err != nil {
+			return err
+		}
+	}
+
+	if flagIsSet(c, scrubTUIFlag) {
+		if err := errMutuallyExclusive(c, scrubTUIFlag, jsonFlag, scrubStreamFlag, scrubOutputFlag); err != nil {
+			return err
+		}
+		ctx.tui = newScrTUI(&ctx)
+	}
+
+	if flagIsSet(c, scrubByLocationFlag) {
+		ctx.locs = make(map[string]*teb.ScrLoc, 8)
+	}
+	if flagIsSet(c, scrubHistoryBucketFlag) {
+		if ctx.hist, err = newScrHist(c); err != nil {
+			return err
+		}
+	}
+
+	if flagIsSet(c, scrubAsyncFlag) {
+		return ctx.async()
+	}
+
+	// Ctrl-C: stop paging and print partial results
+	sigCh, doneCh := make(chan os.Signal, 1), make(chan struct{})
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go ctx.waitSig(sigCh, doneCh)
+	defer close(doneCh)
+
+	ctx.iniLogs()
+	if err := ctx.iniOutFile(); err != nil {
+		return err
+	}
+	if err := ctx.iniScript(); err != nil {
+		return err
+	}
+
+	if err := ctx.tui.start(); err != nil {
+		return err
+	}
+	defer ctx.tui.close()
+
+	if ctx.numBcks > 1 {
+		err = ctx.many(bcks)
+	} else {
+		err = ctx.one()
+	}
+
+	ctx.status.fin(&ctx)
+	ctx.reportDupes()
+	ctx.reportSchema()
+	ctx.reportTop()
+	ctx.reportZero()
+	if err == nil {
+		err = ctx.prefetch()
+	}
+	ctx.closeScript()
+	ctx.closeLogs()
+
+	if ctx.stopped.Load() {
+		fmt.Fprintln(ctx.infoW())
+		actionWarn(c, "interrupted - showing partial results")
+	}
+
+	if err == nil {
+		switch {
+		case flagIsSet(c, scrubCompareToFlag):
+			err = ctx.compareTo(parseStrFlag(c, scrubCompareToFlag))
+		case ctx.hist != nil:
+			err = ctx.compareHist()
+		}
+	}
+	if err == nil && ctx.hist != nil {
+		err = ctx.storeHist()
+	}
+	if errF := ctx.failFast.report(c); err == nil {
+		err = errF
+	}
+	if errF := ctx.failOn.check(ctx.scrubs); err == nil && errF != nil {
+		err = errF
+	}
+	if errI := ctx.runOnIssue(); errI != nil {
+		if err == nil {
+			err = errI
+		} else {
+			actionWarn(c, errI.Error())
+		}
+	}
+
+	// elapsed
+	if !flagIsSet(c, noFooterFlag) && !ctx.jsout && ctx.output == "" {
+		var (
+			n       = ctx.total.Load()
+			elapsed = teb.FmtElapsedRate(n, mono.Since(now))
+			w       = ctx.infoW()
+		)
+		fmt.Fprintln(w, separatorLine)
+		if ctx.numBcks > 1 {
+			fmt.Fprintln(w, "Total:", cos.FormatBigI64(n), "names in", elapsed)
+			ctx.totalsByProvider()
+			ctx.totalSkipped()
+		} else {
+			fmt.Fprintln(w, "Elapsed:", elapsed)
+		}
+	}
+
+	return err
+}
+
+////////////
+// scrCtx //
+////////////
+
+// upon the first signal, stop handling (a second Ctrl-C terminates)
+func (ctx *scrCtx) waitSig(sigCh chan os.Signal, doneCh chan struct{}) {
+	select {
+	case <-sigCh:
+		ctx.stopped.Store(true)
+	case <-doneCh:
+	}
+	signal.Stop(sigCh)
+}
+
+func (ctx *scrCtx) iniLogs() {
+	for i := range ctx.logs {
+		// default
+		log := &ctx.logs[i]
+		log.title = logTitleDflt
+		log.do = log.dflt
+
+		// assorted overrides
+		switch i {
+		case teb.ScrMisplacedNode, teb.ScrMisplacedMpath:
+			log.title = logTitleMisplaced
+			log.do = log.misplaced
+		case teb.ScrVchanged:
+			log.title = logTitleVerChanged
+			log.do = log.vchanged
+		case teb.ScrMissingCp:
+			log.title = logTitleCopies
+			log.do = log.copies
+		}
+	}
+}
+
+func (ctx *scrCtx) iniOutFile() error {
+	if !flagIsSet(ctx.c, scrubOutFileFlag) {
+		return nil
+	}
+	log := &ctx.outf
+	log.fn = parseStrFlag(ctx.c, scrubOutFileFlag)
+	fh, err := cos.CreateFile(log.fn)
+	if err != nil {
+		return fmt.Errorf("failed to create %s %q: %v", qflprn(scrubOutFileFlag), log.fn, err)
+	}
+	log.fh = fh
+	log.title = logTitleOutFile
+	fmt.Fprintln(log.fh, log.title)
+	fmt.Fprintln(log.fh, strings.Repeat("=", len(log.title)))
+	return nil
+}
+
+// with '--json', '--output', and '--stream', keep stdout clean (progress, logs, etc. => stderr)
+func (ctx *scrCtx) infoW() io.Writer {
+	if ctx.jsout || ctx.output != "" || ctx.stream != nil {
+		return ctx.c.App.ErrWriter
+	}
+	return ctx.c.App.Writer
+}
+
+//
+// '--emit-script'
+//
+
+func (ctx *scrCtx) iniScript() error {
+	if !flagIsSet(ctx.c, scrubEmitScriptFlag) {
+		return nil
+	}
+	s := &ctx.script
+	s.fn = parseStrFlag(ctx.c, scrubEmitScriptFlag)
+	fh, err := cos.CreateFile(s.fn)
+	if err != nil {
+		return fmt.Errorf("failed to create %s %q: %v", qflprn(scrubEmitScriptFlag), s.fn, err)
+	}
+	s.fh = fh
+	ctx.tids = make(map[string]struct{}, 4)
+
+	fmt.Fprintln(fh, "#!/bin/sh")
+	fmt.Fprintf(fh, "# generated by '%s %s' on %s\n", ctx.c.Command.HelpName, strings.Join(ctx.c.Args(), " "), time.Now().Format(time.RFC3339))
+	fmt.Fprintln(fh, "# review carefully prior to running")
+	fmt.Fprintln(fh, "set -e")
+	return nil
+}
+
+// zero-size objects: remove
+func (ctx *scrCtx) scriptRm(scr *scrBp, en *cmn.LsoEnt) {
+	s := &ctx.script
+	if s.fh == nil {
+		return
+	}
+	s.mu.Lock()
+	fmt.Fprintln(s.fh, "ais object rm", shquote(scr.Bck.Cname(en.Name)))
+	s.cnt++
+	ctx.numRm++
+	s.mu.Unlock()
+}
+
+// misplaced (mountpath): resilver respective target(s) - emitted upon completion
+func (ctx *scrCtx) scriptResilver(en *cmn.LsoEnt) {
+	if ctx.script.fh == nil || en.Location == "" {
+		return
+	}
+	tname, _, ok := strings.Cut(en.Location, apc.LocationPropSepa)
+	if !ok {
+		return
+	}
+	tid := strings.TrimSuffix(strings.TrimPrefix(tname, meta.TnamePrefix), "]")
+	ctx.script.mu.Lock()
+	ctx.tids[tid] = struct{}{}
+	ctx.script.mu.Unlock()
+}
+
+func (ctx *scrCtx) closeScript() {
+	s := &ctx.script
+	if s.fh == nil {
+		return
+	}
+	var rebalance bool
+	for _, scr := range ctx.scrubs {
+		if scr.Stats[teb.ScrMisplacedNode].Cnt > 0 {
+			rebalance = true
+		}
+		if scr.Stats[teb.ScrMissingCp].Cnt > 0 {
+			fmt.Fprintf(s.fh, "# %s: %d object(s) with missing copies\n", scr.Cname, scr.Stats[teb.ScrMissingCp].Cnt)
+			fmt.Fprintln(s.fh, "ais start", commandMirror, shquote(scr.Cname), "--"+fl1n(copiesFlag.Name),
+				scr.Bck.Props.Mirror.Copies)
+			s.cnt++
+		}
+	}
+	if rebalance {
+		fmt.Fprintln(s.fh, "# misplaced (cluster-wide) objects")
+		fmt.Fprintln(s.fh, "ais start", commandRebalance)
+		s.cnt++
+	}
+	tids := make([]string, 0, len(ctx.tids))
+	for tid := range ctx.tids {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		fmt.Fprintln(s.fh, "# misplaced (mountpath) objects")
+		fmt.Fprintln(s.fh, "ais start", commandResilver, tid)
+		s.cnt++
+	}
+	cos.Close(s.fh)
+	if !ctx.confirmScript() {
+		cos.RemoveFile(s.fn)
+		fmt.Fprintf(ctx.infoW(), "\n%s: %s discarded\n", qflprn(scrubEmitScriptFlag), s.fn)
+		return
+	}
+	if err := os.Chmod(s.fn, 0o755); err != nil {
+		actionWarn(ctx.c, err.Error())
+	}
+	fmt.Fprintf(ctx.infoW(), "\n%s: %s (%d command%s)\n", qflprn(scrubEmitScriptFlag), s.fn, s.cnt, cos.Plural(s.cnt))
+}
+
+// '--confirm-threshold': (listing being the read-only first pass) the count is known
+// before anything gets modified
+func (ctx *scrCtx) confirmScript() bool {
+	threshold := parseIntFlag(ctx.c, scrubConfirmThresholdFlag)
+	if threshold <= 0 || ctx.numRm <= threshold || flagIsSet(ctx.c, yesFlag) {
+		return true
+	}
+	prompt := fmt.Sprintf("The script %q would remove %d objects (%s %d). Keep it?",
+		ctx.script.fn, ctx.numRm, qflprn(scrubConfirmThresholdFlag), threshold)
+	return confirm(ctx.c, prompt)
+}
+
+// multiple positional arguments: union of the respective buckets (no duplicates)
+func (ctx *scrCtx) lsMulti() (bcks cmn.Bcks, err error) {
+	var (
+		all  = append([]cmn.QueryBcks{ctx.qbck}, ctx.more...)
+		seen = make(map[string]struct{}, 8)
+	)
+	for _, qbck := range all {
+		var l cmn.Bcks
+		if qbck.IsBucket() {
+			l = cmn.Bcks{cmn.Bck(qbck)}
+		} else if l, err = api.ListBuckets(apiBP, qbck, apc.FltPresent); err != nil {
+			return nil, err
+		}
+		for _, bck := range l {
+			cname := bck.Cname("")
+			if _, ok := seen[cname]; ok {
+				continue
+			}
+			seen[cname] = struct{}{}
+			bcks = append(bcks, bck)
+		}
+	}
+	ctx.numBcks = len(bcks)
+	if ctx.numBcks == 1 {
+		ctx.qbck = cmn.QueryBcks(bcks[0])
+	}
+	return bcks, nil
+}
+
+// footer: when more than one provider
+func (ctx *scrCtx) totalsByProvider() {
+	type tot struct {
+		names int64
+		bcks  int
+	}
+	m := make(map[string]*tot, 4)
+	for _, scr := range ctx.scrubs {
+		p := apc.DisplayProvider(scr.Bck.Provider)
+		if m[p] == nil {
+			m[p] = &tot{}
+		}
+		m[p].names += scr.Names
+		m[p].bcks++
+	}
+	if len(m) < 2 {
+		return
+	}
+	provs := make([]string, 0, len(m))
+	for p := range m {
+		provs = append(provs, p)
+	}
+	sort.Strings(provs)
+	for _, p := range provs {
+		fmt.Fprintf(ctx.infoW(), "  %s:\t%s names in %d bucket%s\n", p, cos.FormatBigI64(m[p].names), m[p].bcks, cos.Plural(m[p].bcks))
+	}
+}
+
+//
+// buckets that failed to list
+//
+
+const (
+	scrSkipAccess      = "access-denied"
+	scrSkipListing     = "listing-disabled"
+	scrSkipUnreachable = "unreachable"
+	scrSkipNotFound    = "not-found"
+	scrSkipOther       = "other"
+)
+
+var scrSkipHints = map[string]string{
+	scrSkipAccess:      "check credentials and bucket access permissions, e.g. 'ais bucket props show BUCKET access'",
+	scrSkipListing:     "the bucket (or its backend) does not permit listing - check list-objects access and backend policy",
+	scrSkipUnreachable: "backend or cluster node unreachable - check connectivity and retry",
+	scrSkipNotFound:    "the bucket no longer exists or is not visible with the current credentials",
+	scrSkipOther:       "see the error above; retry with a single bucket for details",
+}
+
+func scrSkipReason(err error) string {
+	if _, unreachable := isUnreachableError(err); unreachable {
+		return scrSkipUnreachable
+	}
+	herr := cmn.AsErrHTTP(err)
+	if herr == nil {
+		return scrSkipOther
+	}
+	switch herr.Status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if strings.Contains(strings.ToLower(herr.Message), "list") {
+			return scrSkipListing
+		}
+		return scrSkipAccess
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return scrSkipListing
+	case http.StatusNotFound:
+		return scrSkipNotFound
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return scrSkipUnreachable
+	}
+	return scrSkipOther
+}
+
+// footer: "N buckets skipped (3 access-denied, 2 unreachable)"
+func (ctx *scrCtx) totalSkipped() {
+	if len(ctx.skipped) == 0 {
+		return
+	}
+	var (
+		total   int
+		reasons = make([]string, 0, len(ctx.skipped))
+	)
+	for reason, cnt := range ctx.skipped {
+		total += cnt
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		ci, cj := ctx.skipped[reasons[i]], ctx.skipped[reasons[j]]
+		return ci > cj || (ci == cj && reasons[i] < reasons[j])
+	})
+	parts := make([]string, len(reasons))
+	for i, reason := range reasons {
+		parts[i] = strconv.Itoa(ctx.skipped[reason]) + " " + reason
+	}
+	fmt.Fprintf(ctx.infoW(), "%d bucket%s skipped (%s)\n", total, cos.Plural(total), strings.Join(parts, ", "))
+}
+
+/////////////
+// scrRate //
+/////////////
+
+// objects per second; virtual scheduling (no credit for idle time, the burst being a single page)
+type scrRate struct {
+	next int64   // mono time when the next page can be listed
+	ival float64 // nanoseconds per object
+	mu   sync.Mutex
+}
+
+func newScrRate(rate int) *scrRate {
+	return &scrRate{ival: float64(time.Second) / float64(rate)}
+}
+
+// account for n listed objects and return the time to wait (nil-safe)
+func (r *scrRate) reserve(n int) time.Duration {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	now := mono.NanoTime()
+	r.next = max(r.next, now) + int64(float64(n)*r.ival)
+	sleep := time.Duration(r.next - now)
+	r.mu.Unlock()
+	return sleep
+}
+
+//////////////
+// scrDupes //
+//////////////
+
+// '--find-dupes': checksum (and size) => names
+// - bounded: once the cap is reached new checksums are not tracked (existing groups still grow)
+// - groups get reported upon completion (see reportDupes)
+
+const scrDupesCap = 1 << 20
+
+type (
+	scrDupGroup struct {
+		cksum string
+		names []string
+		size  int64
+	}
+	scrDupes struct {
+		m    map[string]*scrDupGroup
+		mu   sync.Mutex
+		full bool
+	}
+)
+
+// returns true if the entry duplicates (previously listed) content
+func (d *scrDupes) add(cname string, en *cmn.LsoEnt) bool {
+	var (
+		key  = en.Checksum + ":" + strconv.FormatInt(en.Size, 10)
+		name = cname + string(filepath.Separator) + en.Name
+	)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if g, ok := d.m[key]; ok {
+		g.names = append(g.names, name)
+		return true
+	}
+	if len(d.m) >= scrDupesCap {
+		d.full = true
+		return false
+	}
+	d.m[key] = &scrDupGroup{cksum: en.Checksum, names: []string{name}, size: en.Size}
+	return false
+}
+
+// log groups of two or more names (sorted by checksum); include in '--out-file'
+func (ctx *scrCtx) reportDupes() {
+	d := ctx.dupes
+	if d == nil {
+		return
+	}
+	if d.full {
+		actionWarn(ctx.c, fmt.Sprintf("%s: reached the maximum of %d tracked checksums - results are partial",
+			qflprn(scrubFindDupesFlag), scrDupesCap))
+	}
+	groups := make([]*scrDupGroup, 0, 16)
+	for _, g := range d.m {
+		if len(g.names) > 1 {
+			groups = append(groups, g)
+		}
+	}
+	if len(groups) == 0 {
+		return
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].cksum < groups[j].cksum })
+
+	log := &ctx.logs[teb.ScrDupContent]
+	log.tag = strings.ToLower(teb.ScrCols[teb.ScrDupContent])
+	log.title = logTitleDupes
+	(*scrBp)(nil)._create(log, ctx.pid)
+	fmt.Fprintln(log.fh, log.title)
+	fmt.Fprintln(log.fh, strings.Repeat("=", len(log.title)))
+	for _, g := range groups {
+		size := strconv.FormatInt(g.size, 10)
+		sort.Strings(g.names)
+		for _, name := range g.names {
+			fmt.Fprintln(log.fh, `"`+g.cksum+logDelim+name+logDelim+size+`"`)
+			log.cnt++
+			if ctx.outf.fh != nil {
+				fmt.Fprintln(ctx.outf.fh, `"`+log.tag+logDelim+name+logDelim+size+`"`)
+				ctx.outf.cnt++
+			}
+		}
+	}
+	fmt.Fprintf(ctx.infoW(), "\n%s: %d group%s of distinct names sharing the same content\n",
+		qflprn(scrubFindDupesFlag), len(groups), cos.Plural(len(groups)))
+}
+
+/////////////////
+// scrFailFast //
+/////////////////
+
+// '--fail-fast name=N[,name=N...]': stop listing a bucket as soon as any of its counters
+// exceeds the respective limit (checked once per page); the bucket's results are marked
+// partial, and scrub exits with error (pass/fail gate)
+// - name: lowercase column name (e.g., "missing-copies"), or "misplaced" (cluster + mountpath)
+
+type (
+	scrLimit struct {
+		name string
+		idx  []int // stats to sum up
+		n    int64
+	}
+	scrFailFast struct {
+		limits   []scrLimit
+		exceeded []string // "bucket: name 123 > 100"
+		mu       sync.Mutex
+	}
+)
+
+func parseFailFast(s string) (*scrFailFast, error) {
+	ff := &scrFailFast{}
+	for _, kv := range strings.Split(s, ",") {
+		name, val, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			return nil, fmt.Errorf("expecting name=N, got %q", kv)
+		}
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%q: expecting non-negative integer, got %q", name, val)
+		}
+		lim := scrLimit{name: strings.ToLower(name), n: n}
+		if lim.idx = scrStatIdx(lim.name); lim.idx == nil {
+			return nil, fmt.Errorf("unknown name %q (expecting one of: %s)", name, scrStatNames())
+		}
+		ff.limits = append(ff.limits, lim)
+	}
+	return ff, nil
+}
+
+// counter(s) by lowercase column name, or "misplaced" (cluster and mountpath combined)
+func scrStatIdx(name string) []int {
+	if name == "misplaced" {
+		return []int{teb.ScrMisplacedNode, teb.ScrMisplacedMpath}
+	}
+	for i, col := range teb.ScrCols {
+		if strings.ToLower(col) == name {
+			return []int{i}
+		}
+	}
+	return nil
+}
+
+func scrStatNames() string {
+	names := make([]string, 0, len(teb.ScrCols)+1)
+	names = append(names, "misplaced")
+	for _, col := range teb.ScrCols {
+		names = append(names, strings.ToLower(col))
+	}
+	return strings.Join(names, ", ")
+}
+
+//
+// '--sort'
+//
+
+// returns "less" for the per-bucket results: by bucket name (ascending),
+// or by any counter(s) - descending unless ":asc"
+func parseScrSort(s string) (func(a, b *scrBp) bool, error) {
+	name, order, _ := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	var desc bool
+	switch order {
+	case "":
+		desc = name != "bucket"
+	case "asc":
+	case "desc":
+		desc = true
+	default:
+		return nil, fmt.Errorf("invalid order %q (expecting \"asc\" or \"desc\")", order)
+	}
+	if name == "bucket" {
+		return func(a, b *scrBp) bool {
+			ca, cb := a.Bck.Cname(a.Prefix), b.Bck.Cname(b.Prefix)
+			return (ca < cb) != desc
+		}, nil
+	}
+	idx := scrStatIdx(name)
+	if idx == nil {
+		return nil, fmt.Errorf("unknown name %q (expecting \"bucket\" or one of: %s)", name, scrStatNames())
+	}
+	cnt := func(scr *scrBp) (n int64) {
+		for _, i := range idx {
+			n += scr.Stats[i].Cnt
+		}
+		return n
+	}
+	return func(a, b *scrBp) bool {
+		ca, cb := cnt(a), cnt(b)
+		if ca == cb {
+			return a.Bck.Cname(a.Prefix) < b.Bck.Cname(b.Prefix)
+		}
+		return (ca < cb) != desc
+	}, nil
+}
+
+// nil-safe; returns true (and records) when exceeded
+func (ff *scrFailFast) check(scr *scrBp) bool {
+	if ff == nil {
+		return false
+	}
+	for _, lim := range ff.limits {
+		var cnt int64
+		for _, i := range lim.idx {
+			cnt += scr.Stats[i].Cnt
+		}
+		if cnt > lim.n {
+			ff.mu.Lock()
+			ff.exceeded = append(ff.exceeded, fmt.Sprintf("%s: %s %d > %d", scr.Cname, lim.name, cnt, lim.n))
+			ff.mu.Unlock()
+			return true
+		}
+	}
+	return false
+}
+
+func (ff *scrFailFast) report(c *cli.Context) error {
+	if ff == nil || len(ff.exceeded) == 0 {
+		return nil
+	}
+	sort.Strings(ff.exceeded)
+	for _, s := range ff.exceeded {
+		actionWarn(c, "threshold exceeded: "+s)
+	}
+	n := len(ff.exceeded)
+	return fmt.Errorf("%s: threshold exceeded in %d bucket%s", qflprn(scrubFailFastFlag), n, cos.Plural(n))
+}
+
+///////////////
+// scrFailOn //
+///////////////
+
+// '--fail-on name[,name...]': upon completion, fail (exit status 1) if any of the named
+// counters is non-zero in any of the scrubbed buckets (compare with '--fail-fast')
+// - names: same as '--fail-fast', plus "zero-size" (objects of size zero)
+
+const scrZeroSize = "zero-size"
+
+type (
+	scrFailOn   []scrLimit // (limits are zero)
+	errScrFound struct {
+		found []string // "name N (in K buckets)"
+	}
+)
+
+func parseFailOn(s string) (scrFailOn, error) {
+	var fo scrFailOn
+	for _, name := range strings.Split(s, ",") {
+		lim := scrLimit{name: strings.ToLower(strings.TrimSpace(name))}
+		if lim.name != scrZeroSize {
+			if lim.idx = scrStatIdx(lim.name); lim.idx == nil {
+				return nil, fmt.Errorf("unknown name %q (expecting one of: %s, %s)", name, scrStatNames(), scrZeroSize)
+			}
+		}
+		fo = append(fo, lim)
+	}
+	return fo, nil
+}
+
+// returns *errScrFound if found
+func (fo scrFailOn) check(scrubs []*scrBp) error {
+	var found []string
+	for _, lim := range fo {
+		var total, nb int64
+		for _, scr := range scrubs {
+			cnt := scr.ZeroSizeCnt
+			if lim.idx != nil {
+				cnt = 0
+				for _, i := range lim.idx {
+					cnt += scr.Stats[i].Cnt
+				}
+			}
+			if cnt > 0 {
+				total += cnt
+				nb++
+			}
+		}
+		if total > 0 {
+			found = append(found, fmt.Sprintf("%s %d (in %d bucket%s)", lim.name, total, nb, cos.Plural(int(nb))))
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return &errScrFound{found: found}
+}
+
+func (e *errScrFound) Error() string {
+	return fmt.Sprintf("%s: found %s", qflprn(scrubFailOnFlag), strings.Join(e.found, ", "))
+}
+
+//
+// '--on-issue'
+//
+
+// no shell: split on whitespace and exec as is
+func parseOnIssue(s string) ([]string, error) {
+	argv := strings.Fields(s)
+	if len(argv) == 0 {
+		return nil, errors.New("empty command")
+	}
+	if _, err := exec.LookPath(argv[0]); err != nil {
+		return nil, err
+	}
+	return argv, nil
+}
+
+func (ctx *scrCtx) runOnIssue() error {
+	if len(ctx.onIssue) == 0 {
+		return nil
+	}
+	out := make([]*teb.ScrBp, 0, len(ctx.scrubs))
+	for _, scr := range ctx.scrubs {
+		out = append(out, (*teb.ScrBp)(scr))
+	}
+	n := 0
+	for _, scr := range out {
+		if scr.HasIssues() {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	b, err := jsoniter.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(ctx.onIssue[0], ctx.onIssue[1:]...) //nolint:gosec // user-specified, no shell
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Stdout = ctx.infoW()
+	cmd.Stderr = ctx.c.App.ErrWriter
+	if err := cmd.Run(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return fmt.Errorf("%s: %q (%d bucket%s with issues) exited with code %d",
+				qflprn(scrubOnIssueFlag), ctx.onIssue[0], n, cos.Plural(n), ee.ExitCode())
+		}
+		return fmt.Errorf("%s: %v", qflprn(scrubOnIssueFlag), err)
+	}
+	return nil
+}
+
+///////////////
+// scrCopies //
+///////////////
+
+// '--verify-copies': with apc.LsMissing, each copy of a mirrored object is listed as a separate
+// entry (apc.LocIsCopy) carrying its own (stored) metadata; listed pages are sorted by name,
+// so all replicas of a given object are consecutive - possibly spanning two pages
+
+type scrCopies struct {
+	pending []*cmn.LsoEnt // replicas of the same object
+}
+
+// nil-safe
+func (sc *scrCopies) add(parent *scrCtx, scr *scrBp, en *cmn.LsoEnt) {
+	if sc == nil || (en.Copies < 2 && en.Status() != apc.LocIsCopy) {
+		return
+	}
+	if len(sc.pending) > 0 && sc.pending[0].Name != en.Name {
+		sc.flush(parent, scr)
+	}
+	sc.pending = append(sc.pending, en)
+}
+
+// nil-safe
+func (sc *scrCopies) flush(parent *scrCtx, scr *scrBp) {
+	if sc == nil || len(sc.pending) < 2 {
+		if sc != nil {
+			sc.pending = sc.pending[:0]
+		}
+		return
+	}
+	var (
+		main = sc.pending[0]
+		diff bool
+	)
+	for _, en := range sc.pending {
+		if en.Status() != apc.LocIsCopy {
+			main = en
+			break
+		}
+	}
+	for _, en := range sc.pending {
+		if en != main && (en.Checksum != main.Checksum || en.Size != main.Size) {
+			diff = true
+			break
+		}
+	}
+	if diff {
+		scr.Stats[teb.ScrCopyDiverged].Cnt++
+		scr.Stats[teb.ScrCopyDiverged].Siz += main.Size
+		scr.log(parent, main, teb.ScrCopyDiverged)
+	}
+	sc.pending = sc.pending[:0]
+}
+
+//////////////////
+// scrEmptyDirs //
+//////////////////
+
+// '--report-empty-dirs': (recursive) listed pages are sorted by name, and so a directory entry
+// is immediately followed by its contents, if any; keep the stack of nested directories
+// that have no objects so far:
+// - an object clears the stack (all its ancestors are non-empty)
+// - any other name pops (and reports) directories that are not its prefix
+
+type scrEmptyDirs struct {
+	stack []*cmn.LsoEnt
+}
+
+func isScrDir(en *cmn.LsoEnt) bool {
+	return en.IsAnyFlagSet(apc.EntryIsDir) || cos.IsLastB(en.Name, filepath.Separator)
+}
+
+// nil-safe
+func (eds *scrEmptyDirs) push(parent *scrCtx, scr *scrBp, en *cmn.LsoEnt) {
+	if eds == nil {
+		return
+	}
+	eds.flush(parent, scr, en.Name)
+	eds.stack = append(eds.stack, en)
+}
+
+// nil-safe
+func (eds *scrEmptyDirs) child(parent *scrCtx, scr *scrBp, en *cmn.LsoEnt) {
+	if eds == nil || len(eds.stack) == 0 {
+		return
+	}
+	eds.flush(parent, scr, en.Name)
+	eds.stack = eds.stack[:0]
+}
+
+// report (and pop) directories that are not a prefix of `name`; empty name: all
+func (eds *scrEmptyDirs) flush(parent *scrCtx, scr *scrBp, name string) {
+	if eds == nil {
+		return
+	}
+	for l := len(eds.stack); l > 0; l-- {
+		dir := eds.stack[l-1]
+		if name != "" && strings.HasPrefix(name, dirName(dir)) {
+			break
+		}
+		scr.Stats[teb.ScrEmptyDir].Cnt++
+		scr.log(parent, dir, teb.ScrEmptyDir)
+		eds.stack = eds.stack[:l-1]
+	}
+}
+
+// (with EntryIsDir, the name may not have the trailing separator)
+func dirName(en *cmn.LsoEnt) string {
+	if cos.IsLastB(en.Name, filepath.Separator) {
+		return en.Name
+	}
+	return en.Name + string(filepath.Separator)
+}
+
+////////////
+// scrTop //
+////////////
+
+// '--top N': the N largest objects (a min-heap: the smallest of the N at the root)
+// - memory bounded by N regardless of the number of listed objects
+
+const scrTopMax = 10_000
+
+type (
+	scrTopEnt struct {
+		name string // bucket/object
+		size int64
+	}
+	scrTop struct {
+		h  scrTopHeap
+		n  int
+		mu sync.Mutex
+	}
+	scrTopHeap []scrTopEnt
+)
+
+func (h scrTopHeap) Len() int           { return len(h) }
+func (h scrTopHeap) Less(i, j int) bool { return h[i].size < h[j].size }
+func (h scrTopHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *scrTopHeap) Push(x any)        { *h = append(*h, x.(scrTopEnt)) }
+
+func (h *scrTopHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// nil-safe
+func (top *scrTop) add(cname string, en *cmn.LsoEnt) {
+	if top == nil {
+		return
+	}
+	top.mu.Lock()
+	switch {
+	case len(top.h) < top.n:
+		heap.Push(&top.h, scrTopEnt{name: cname + string(filepath.Separator) + en.Name, size: en.Size})
+	case en.Size > top.h[0].size:
+		top.h[0] = scrTopEnt{name: cname + string(filepath.Separator) + en.Name, size: en.Size}
+		heap.Fix(&top.h, 0)
+	}
+	top.mu.Unlock()
+}
+
+// largest first
+func (top *scrTop) sorted() []scrTopEnt {
+	ens := make([]scrTopEnt, len(top.h))
+	copy(ens, top.h)
+	sort.Slice(ens, func(i, j int) bool {
+		return ens[i].size > ens[j].size || (ens[i].size == ens[j].size && ens[i].name < ens[j].name)
+	})
+	return ens
+}
+
+func (ctx *scrCtx) reportTop() {
+	top := ctx.top
+	if top == nil || len(top.h) == 0 {
+		return
+	}
+	var (
+		ens = top.sorted()
+		w   = ctx.infoW()
+		tag = "top"
+	)
+	fmt.Fprintf(w, "\n%s: %d largest object%s:\n", qflprn(scrubTopFlag), len(ens), cos.Plural(len(ens)))
+	for _, en := range ens {
+		fmt.Fprintf(w, "%s%-12s %s\n", indent1, teb.FmtSize(en.size, ctx.units, 2), en.name)
+		if ctx.outf.fh != nil {
+			fmt.Fprintln(ctx.outf.fh, `"`+tag+logDelim+en.name+logDelim+strconv.FormatInt(en.size, 10)+`"`)
+			ctx.outf.cnt++
+		}
+	}
+}
+
+//
+// '--only-zero-size'
+//
+
+const scrMaxNamesDflt = 1000
+
+// (upd is serialized per bucket - no locking)
+func (scr *scrBp) zero(parent *scrCtx, en *cmn.LsoEnt) {
+	scr.ZeroSizeCnt++ // (see also '--fail-on')
+	if parent.onlyZero && len(scr.ZeroSize) < parent.maxNames {
+		scr.ZeroSize = append(scr.ZeroSize, en.Name)
+	}
+}
+
+// (with '--json' the names are part of the results)
+func (ctx *scrCtx) reportZero() {
+	if !ctx.onlyZero || ctx.jsout {
+		return
+	}
+	w := ctx.infoW()
+	for _, scr := range ctx.scrubs {
+		if len(scr.ZeroSize) == 0 {
+			continue
+		}
+		n := scr.ZeroSizeCnt
+		fmt.Fprintf(w, "\n%s: %s: %d zero-size object%s:\n", qflprn(scrubOnlyZeroFlag), scr.Cname, n, cos.Plural(int(n)))
+		for _, name := range scr.ZeroSize {
+			fmt.Fprintln(w, indent1+name)
+		}
+		if more := n - int64(len(scr.ZeroSize)); more > 0 {
+			fmt.Fprintf(w, "%s... and %d more (see %s)\n", indent1, more, qflprn(scrubMaxNamesFlag))
+		}
+	}
+}
+
+/////////////////
+// scrPrefetch //
+/////////////////
+
+// '--prefetch': remote objects not present in the cluster => prefetch job per bucket
+// - names are collected while listing (bounded - see scrPrefetchCap) and prefetched upon completion
+// - '--confirm-threshold' applies
+
+const (
+	scrPrefetchCap   = 1 << 20
+	scrPrefetchBatch = 10_000 // names per prefetch request
+)
+
+type (
+	scrPfBck struct {
+		bck   cmn.Bck
+		names []string
+	}
+	scrPrefetch struct {
+		m    map[string]*scrPfBck // by bucket cname
+		mu   sync.Mutex
+		cnt  int
+		full bool
+	}
+)
+
+// nil-safe
+func (pf *scrPrefetch) add(scr *scrBp, en *cmn.LsoEnt) {
+	if pf == nil {
+		return
+	}
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+	if pf.cnt >= scrPrefetchCap {
+		pf.full = true
+		return
+	}
+	b, ok := pf.m[scr.Cname]
+	if !ok {
+		b = &scrPfBck{bck: scr.Bck}
+		pf.m[scr.Cname] = b
+	}
+	b.names = append(b.names, en.Name)
+	pf.cnt++
+}
+
+func (ctx *scrCtx) prefetch() error {
+	pf := ctx.pf
+	if pf == nil || pf.cnt == 0 {
+		return nil
 	}
-
-	ctx.pid = os.Getpid()
-
-	ctx.iniLogs()
-
-	if ctx.numBcks > 1 {
-		err = ctx.many(bcks)
-	} else {
-		err = ctx.one()
+	if ctx.stopped.Load() {
+		actionWarn(ctx.c, fmt.Sprintf("%s: interrupted - not prefetching", qflprn(scrubPrefetchFlag)))
+		return nil
 	}
-
-	ctx.closeLogs(c)
-
-	// elapsed
-	if !flagIsSet(c, noFooterFlag) {
-		elapsed := teb.FormatDuration(mono.Since(now))
-		fmt.Fprintln(c.App.Writer, separatorLine)
-		if ctx.numBcks > 1 {
-			total := cos.FormatBigI64(ctx.total.Load())
-			fmt.Fprintln(c.App.Writer, "Total:", total, "names in", elapsed)
-		} else {
-			fmt.Fprintln(c.App.Writer, "Elapsed:", elapsed)
+	if pf.full {
+		actionWarn(ctx.c, fmt.Sprintf("%s: reached the maximum of %d names - prefetching only those",
+			qflprn(scrubPrefetchFlag), scrPrefetchCap))
+	}
+	threshold := parseIntFlag(ctx.c, scrubConfirmThresholdFlag)
+	if threshold > 0 && pf.cnt > threshold && !flagIsSet(ctx.c, yesFlag) {
+		prompt := fmt.Sprintf("Prefetch %d objects (%s %d)?", pf.cnt, qflprn(scrubConfirmThresholdFlag), threshold)
+		if !confirm(ctx.c, prompt) {
+			return nil
 		}
 	}
 
-	return err
+	cnames := make([]string, 0, len(pf.m))
+	for cname := range pf.m {
+		cnames = append(cnames, cname)
+	}
+	sort.Strings(cnames)
+	var requested int
+	for _, cname := range cnames {
+		b := pf.m[cname]
+		for i := 0; i < len(b.names); i += scrPrefetchBatch {
+			var msg apc.PrefetchMsg
+			msg.ObjNames = b.names[i:min(i+scrPrefetchBatch, len(b.names))]
+			xid, err := api.Prefetch(apiBP, b.bck, &msg)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %v (requested %d out of %d)", qflprn(scrubPrefetchFlag), cname, err, requested, pf.cnt)
+			}
+			requested += len(msg.ObjNames)
+			fmt.Fprintf(ctx.infoW(), "%s: %s: prefetching %d object%s, %s\n", qflprn(scrubPrefetchFlag), cname,
+				len(msg.ObjNames), cos.Plural(len(msg.ObjNames)), xact.Cname(apc.ActPrefetchObjects, xid))
+		}
+	}
+	fmt.Fprintf(ctx.infoW(), "%s: requested %d object%s in %d bucket%s\n", qflprn(scrubPrefetchFlag),
+		requested, cos.Plural(requested), len(cnames), cos.Plural(len(cnames)))
+	return nil
 }
 
-////////////
-// scrCtx //
-////////////
+///////////////
+// scrStream //
+///////////////
 
-func (ctx *scrCtx) iniLogs() {
-	for i := range ctx.logs {
-		// default
-		log := &ctx.logs[i]
-		log.title = logTitleDflt
-		log.do = log.dflt
+// '--stream': one JSON line per flagged object, written out as soon as it's found
 
-		// assorted overrides
-		switch i {
-		case teb.ScrMisplacedNode, teb.ScrMisplacedMpath:
-			log.title = logTitleMisplaced
-			log.do = log.misplaced
-		case teb.ScrVchanged:
-			log.title = logTitleVerChanged
-			log.do = log.vchanged
-		case teb.ScrMissingCp:
-			log.title = logTitleCopies
-			log.do = log.copies
-		}
+type (
+	scrStream struct {
+		w  io.Writer
+		mu sync.Mutex
+	}
+	scrRec struct {
+		Bucket string `json:"bucket"`
+		Name   string `json:"name"`
+		Issue  string `json:"issue"`
+		Size   int64  `json:"size"`
 	}
+)
+
+func (s *scrStream) emit(scr *scrBp, en *cmn.LsoEnt, issue string) {
+	b, err := jsoniter.Marshal(scrRec{Bucket: scr.Cname, Name: en.Name, Issue: issue, Size: en.Size})
+	debug.AssertNoErr(err)
+	b = append(b, '\n')
+	s.mu.Lock()
+	s.w.Write(b)
+	s.mu.Unlock()
 }
 
-func (ctx *scrCtx) closeLogs(c *cli.Context) {
+// single-quote for POSIX shell
+func shquote(s string) string { return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'" }
+
+func (ctx *scrCtx) closeLogs() {
 	var titled bool
+	defer func() {
+		if log := &ctx.outf; log.fh != nil {
+			cos.Close(log.fh)
+			fmt.Fprintf(ctx.infoW(), "\n%s: %s (%d record%s)\n", qflprn(scrubOutFileFlag), log.fn, log.cnt, cos.Plural(log.cnt))
+		}
+	}()
 	for i := 1; i < len(ctx.logs); i++ { // skipping listed objects
 		log := &ctx.logs[i]
 		if log.fh == nil {
@@ -232,16 +1583,19 @@ func (ctx *scrCtx) closeLogs(c *cli.Context) {
 		cos.Close(log.fh)
 		if !titled {
 			const title = "Detailed Logs"
-			fmt.Fprintln(c.App.Writer)
-			fmt.Fprintln(c.App.Writer, fcyan(title))
-			fmt.Fprintln(c.App.Writer, strings.Repeat("-", len(title)))
+			fmt.Fprintln(ctx.infoW())
+			fmt.Fprintln(ctx.infoW(), fcyan(title))
+			fmt.Fprintln(ctx.infoW(), strings.Repeat("-", len(title)))
 			titled = true
 		}
-		fmt.Fprintf(c.App.Writer, "* %s objects: \t%s (%d record%s)\n", log.tag, log.fn, log.cnt, cos.Plural(log.cnt))
+		fmt.Fprintf(ctx.infoW(), "* %s objects: \t%s (%d record%s)\n", log.tag, log.fn, log.cnt, cos.Plural(log.cnt))
 	}
 }
 
 func (ctx *scrCtx) lsBcks() (bcks cmn.Bcks, err error) {
+	if len(ctx.more) > 0 {
+		return ctx.lsMulti()
+	}
 	if ctx.qbck.IsBucket() {
 		ctx.numBcks = 1
 		return
@@ -275,22 +1629,227 @@ func (ctx *scrCtx) many(bcks cmn.Bcks) error {
 
 // print and be done
 func (ctx *scrCtx) prnt() error {
+	ctx.tui.wait()
+	ctx.tui.close()
+	if ctx.less != nil {
+		sort.SliceStable(ctx.scrubs, func(i, j int) bool { return ctx.less(ctx.scrubs[i], ctx.scrubs[j]) })
+	}
 	out := make([]*teb.ScrBp, len(ctx.scrubs))
 	for i, scr := range ctx.scrubs {
 		out[i] = (*teb.ScrBp)(scr)
 	}
-	all := teb.ScrubHelper{All: out}
-	tab := all.MakeTab(ctx.units, ctx.haveRemote.Load(), flagIsSet(ctx.c, allColumnsFlag))
+	if ctx.jsout {
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+	if ctx.output != "" {
+		return prntScrOutput(ctx.c.App.Writer, ctx.output, out, flagIsSet(ctx.c, noHeaderFlag))
+	}
+	if ctx.tmpl != "" {
+		tmpl, _ := teb.Lookup(ctx.tmpl)
+		return teb.Print(out, tmpl)
+	}
+	all := teb.ScrubHelper{All: out, Subtotals: flagIsSet(ctx.c, scrubSubtotalsFlag) && ctx.numBcks > 1}
+	var (
+		wide = flagIsSet(ctx.c, scrubWideFlag)
+		tab  = all.MakeTab(ctx.units, ctx.haveRemote.Load() || wide, flagIsSet(ctx.c, allColumnsFlag) || wide, ctx.optIn()...)
+	)
+
+	if err := teb.Print(out, tab.Template(flagIsSet(ctx.c, noHeaderFlag))); err != nil {
+		return err
+	}
+	return ctx.prntLocs()
+}
+
+// enabled opt-in metrics
+func (ctx *scrCtx) optIn() (enabled []int) {
+	if ctx.namePolicy != nil {
+		enabled = append(enabled, teb.ScrBadName)
+	}
+	if ctx.deep {
+		enabled = append(enabled, teb.ScrMetaMismatch)
+	}
+	if len(ctx.excl) > 0 {
+		enabled = append(enabled, teb.ScrExcluded)
+	}
+	if ctx.pendingDel {
+		enabled = append(enabled, teb.ScrPendingDel)
+	}
+	if ctx.dupes != nil {
+		enabled = append(enabled, teb.ScrDupContent)
+	}
+	if ctx.schema != nil {
+		enabled = append(enabled, teb.ScrSchemaInvalid)
+	}
+	if ctx.noCksum {
+		enabled = append(enabled, teb.ScrNoCksum)
+	}
+	if ctx.verifyCopies {
+		enabled = append(enabled, teb.ScrCopyDiverged)
+	}
+	if ctx.emptyDirs {
+		enabled = append(enabled, teb.ScrEmptyDir)
+	}
+	return enabled
+}
+
+// '--template': registered name or file; validate upfront (before listing)
+func scrubTemplate(c *cli.Context) (string, error) {
+	name := parseStrFlag(c, scrubTemplateFlag)
+	if _, ok := teb.Lookup(name); !ok {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("%s: %q is neither a registered template nor a readable file: %v", qflprn(scrubTemplateFlag), name, err)
+		}
+		if err := teb.Register(name, string(b)); err != nil {
+			return "", err
+		}
+	}
+	return name, teb.Validate(name, []*teb.ScrBp{{}})
+}
+
+// '--compare-to': show deltas vs previously saved ('--json' or '--output=json') result
+func (ctx *scrCtx) compareTo(fn string) error {
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		return fmt.Errorf("%s: %v", qflprn(scrubCompareToFlag), err)
+	}
+	var prev []*teb.ScrBp
+	if err := jsoniter.Unmarshal(b, &prev); err != nil {
+		return fmt.Errorf("%s: failed to parse %q: %v", qflprn(scrubCompareToFlag), fn, err)
+	}
+	ctx.compare("Compared to "+fn, prev)
+	return nil
+}
+
+// '--history-bucket' (w/o '--compare-to'): vs the most recent stored summary
+func (ctx *scrCtx) compareHist() error {
+	rec, cname, err := ctx.hist.latest()
+	if err != nil {
+		return fmt.Errorf("%s: failed to load %s: %v", qflprn(scrubHistoryBucketFlag), cname, err)
+	}
+	if rec == nil {
+		return nil
+	}
+	ctx.compare("Compared to "+cname+" ("+rec.Finished.Format(time.DateTime)+")", rec.Results)
+	return nil
+}
+
+func (ctx *scrCtx) compare(title string, prev []*teb.ScrBp) {
+	prevm := make(map[string]*teb.ScrBp, len(prev))
+	for _, scr := range prev {
+		prevm[scr.Bck.Cname(scr.Prefix)] = scr
+	}
+
+	var (
+		w    = ctx.infoW()
+		same = true
+	)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, fcyan(title))
+	fmt.Fprintln(w, strings.Repeat("-", len(title)))
+
+	curr := make([]*scrBp, len(ctx.scrubs))
+	copy(curr, ctx.scrubs)
+	sort.Slice(curr, func(i, j int) bool { return curr[i].Bck.Cname(curr[i].Prefix) < curr[j].Bck.Cname(curr[j].Prefix) })
+	for _, scr := range curr {
+		cname := scr.Bck.Cname(scr.Prefix)
+		old, ok := prevm[cname]
+		if !ok {
+			fmt.Fprintln(w, cname+":", fgreen("new"))
+			same = false
+			continue
+		}
+		delete(prevm, cname)
+		var hdr bool
+		for i := range scr.Stats {
+			a, b := old.Stats[i].Cnt, scr.Stats[i].Cnt
+			if a == b {
+				continue
+			}
+			if !hdr {
+				fmt.Fprintln(w, cname+":")
+				hdr = true
+			}
+			fmt.Fprintf(w, "  %s: %d → %d (%+d)\n", strings.ToLower(teb.ScrCols[i]), a, b, b-a)
+		}
+		if hdr {
+			same = false
+		}
+	}
+	removed := make([]string, 0, len(prevm))
+	for cname := range prevm {
+		removed = append(removed, cname)
+	}
+	sort.Strings(removed)
+	for _, cname := range removed {
+		fmt.Fprintln(w, cname+":", fred("removed"))
+		same = false
+	}
+	if same {
+		fmt.Fprintln(w, "no changes")
+	}
+}
+
+// '--by-location'
+func (ctx *scrCtx) prntLocs() error {
+	if len(ctx.locs) == 0 {
+		return nil
+	}
+	locs := make(teb.ScrLocs, 0, len(ctx.locs))
+	for _, loc := range ctx.locs {
+		locs = append(locs, loc)
+	}
+	sort.Slice(locs, func(i, j int) bool { return locs[i].Location < locs[j].Location })
+	n := ctx.locHealth(locs)
+
+	fmt.Fprintln(ctx.infoW())
+	tab := locs.MakeTab(ctx.units)
+	if err := teb.Print(locs, tab.Template(flagIsSet(ctx.c, noHeaderFlag))); err != nil {
+		return err
+	}
+	if n > 0 {
+		actionNote(ctx.c, fmt.Sprintf("%d location(s) on unavailable or degraded mountpaths (or targets) - "+
+			"likely disk issues rather than data; see 'ais storage mountpath show'", n))
+	}
+	return nil
+}
 
-	return teb.Print(out, tab.Template(flagIsSet(ctx.c, noHeaderFlag)))
+func (ctx *scrCtx) byLoc(en *cmn.LsoEnt, i int) {
+	if ctx.locs == nil || en.Location == "" {
+		return
+	}
+	ctx.locMu.Lock()
+	loc, ok := ctx.locs[en.Location]
+	if !ok {
+		loc = &teb.ScrLoc{Location: en.Location}
+		ctx.locs[en.Location] = loc
+	}
+	cs := &loc.Misplaced
+	if i == teb.ScrMissingCp {
+		cs = &loc.MissingCp
+	}
+	cs.Cnt++
+	cs.Siz += en.Size
+	ctx.locMu.Unlock()
 }
 
 func (ctx *scrCtx) gols(bck cmn.Bck, wg cos.WG, mu *sync.Mutex) {
 	defer wg.Done()
+	if ctx.stopped.Load() {
+		return
+	}
 	scr, err := ctx.ls(bck)
 	if err != nil {
-		warn := fmt.Sprintf("cannot validate %s: %v", bck.Cname(ctx.pref), err)
+		reason := scrSkipReason(err)
+		ctx.tui.skip(bck.Cname(""), reason)
+		warn := fmt.Sprintf("skipping %s: %s: %v\n(Hint: %s)", bck.Cname(ctx.pref), reason, err, scrSkipHints[reason])
 		actionWarn(ctx.c, warn)
+		mu.Lock()
+		if ctx.skipped == nil {
+			ctx.skipped = make(map[string]int, 4)
+		}
+		ctx.skipped[reason]++
+		mu.Unlock()
 		return
 	}
 	mu.Lock()
@@ -324,7 +1883,27 @@ func (ctx *scrCtx) ls(bck cmn.Bck) (*scrBp, error) {
 		}
 	)
 	scr.Cname = bck.Cname("")
+	ctx.tui.upd(scr)
+	if scr.skipVC() && (ctx.deep || bck.IsRemote()) {
+		fmt.Fprintf(ctx.infoW(), "%s: version and checksum checks skipped (%s)\n", scr.Cname, feat.SkipVC.Names()[0])
+	}
 	propNames := []string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsVersion, apc.GetPropsCopies, apc.GetPropsLocation, apc.GetPropsCustom}
+	if ctx.deep || ctx.dupes != nil || ctx.noCksum || ctx.verifyCopies {
+		lsmsg.AddProps(apc.GetPropsChecksum)
+	}
+	if ctx.pendingDel || ctx.verifyCopies {
+		lsmsg.AddProps(apc.GetPropsStatus)
+	}
+	var (
+		cps *scrCopies
+		eds *scrEmptyDirs
+	)
+	if ctx.verifyCopies && bck.Props.Mirror.Enabled {
+		cps = &scrCopies{}
+	}
+	if ctx.emptyDirs {
+		eds = &scrEmptyDirs{}
+	}
 	if bck.IsRemote() {
 		lsmsg.Flags |= apc.LsDiff
 		lsmsg.AddProps(propNames...)
@@ -348,25 +1927,63 @@ func (ctx *scrCtx) ls(bck cmn.Bck) (*scrBp, error) {
 	lsargs.Limit = limit
 
 	var (
-		pgcnt  int
-		listed int64
-		yes    bool
+		pgr     *scrPager
+		token   string
+		pgcnt   int
+		listed  int64
+		yes     bool
+		rate    *scrRate
+		started = mono.NanoTime()
 	)
+	if ctx.rate > 0 {
+		rate = newScrRate(ctx.rate)
+	}
+	if ctx.pagesAhead > 0 {
+		pgr = newScrPager(bck, lsmsg, lsargs, ctx.pagesAhead)
+		defer pgr.close()
+	}
 	// main loop (pages)
 	for {
-		lst, err := api.ListObjectsPage(apiBP, bck, lsmsg, lsargs)
-		if err != nil {
-			return nil, err
+		pg := pgr.next(bck, lsmsg, lsargs)
+		if pg.err != nil {
+			return nil, pg.err
 		}
+		lst := pg.lst
+		token = pg.token
 		ctx.total.Add(int64(len(lst.Entries)))
+		scr.Names += int64(len(lst.Entries))
 		// one page
+		var verify, validate []*cmn.LsoEnt
 		for _, en := range lst.Entries {
-			if en.IsAnyFlagSet(apc.EntryIsDir) || cos.IsLastB(en.Name, filepath.Separator) {
+			if isScrDir(en) {
+				eds.push(ctx, scr, en)
 				continue
 			}
-			scr.upd(ctx, en)
+			eds.child(ctx, scr, en)
+			if !scr.upd(ctx, en) {
+				continue
+			}
+			cps.add(ctx, scr, en)
+			if ctx.deep {
+				verify = append(verify, en)
+			}
+			if ctx.schema != nil && ctx.schema.pick() {
+				validate = append(validate, en)
+			}
 		}
-		if lsmsg.ContinuationToken == "" {
+		if len(verify) > 0 {
+			scr.deep(ctx, verify)
+		}
+		if len(validate) > 0 {
+			scr.validate(ctx, validate)
+		}
+		exceeded := ctx.failFast.check(scr)
+		ctx.status.upd(scr, token, false)
+		if token == "" {
+			break
+		}
+		if ctx.stopped.Load() || exceeded {
+			scr.Partial = true
 			break
 		}
 		pgcnt++
@@ -379,15 +1996,90 @@ func (ctx *scrCtx) ls(bck cmn.Bck) (*scrBp, error) {
 		}
 
 		ctx.progress(scr, listed, &yes)
+		ctx.throttle(rate, len(lst.Entries))
 	}
 
+	cps.flush(ctx, scr)
+	eds.flush(ctx, scr, "")
+	ctx.status.upd(scr, token, true)
 	if yes {
-		fmt.Fprintln(ctx.c.App.Writer)
+		fmt.Fprintln(ctx.infoW())
+	}
+	scr.Elapsed = mono.Since(started)
+	ctx.tui.done(scr)
+	if rate != nil || ctx.rateTotal != nil {
+		eff := float64(listed) / max(scr.Elapsed.Seconds(), 1e-3)
+		fmt.Fprintf(ctx.infoW(), "%s: effective list rate %.0f objects/s\n", scr.Cname, eff)
 	}
 	return scr, nil
 }
 
+//
+// '--prefetch-pages': list the next page(s) while processing the current one
+// - the lister (goroutine) owns lsmsg and stays up to N pages ahead: N-1 buffered plus one in flight
+// - all per-page processing (upd, progress, status, etc.) remains serialized on the caller's goroutine
+// - upon early exit (Ctrl-C, '--max-pages', '--limit', '--fail-fast') the caller stops the lister
+//   without waiting for the in-flight page
+//
+
+const scrPagesAheadMax = 16
+
+type (
+	scrPage struct {
+		lst   *cmn.LsoRes
+		err   error
+		token string // continuation token that follows this page
+	}
+	scrPager struct {
+		ch   chan scrPage
+		stop chan struct{}
+	}
+)
+
+func newScrPager(bck cmn.Bck, lsmsg *apc.LsoMsg, lsargs api.ListArgs, n int) *scrPager {
+	pgr := &scrPager{ch: make(chan scrPage, n-1), stop: make(chan struct{})}
+	go pgr.run(bck, lsmsg, lsargs)
+	return pgr
+}
+
+func (pgr *scrPager) run(bck cmn.Bck, lsmsg *apc.LsoMsg, lsargs api.ListArgs) {
+	for {
+		lst, err := api.ListObjectsPage(apiBP, bck, lsmsg, lsargs)
+		pg := scrPage{lst: lst, err: err, token: lsmsg.ContinuationToken}
+		select {
+		case pgr.ch <- pg:
+		case <-pgr.stop:
+			return
+		}
+		if err != nil || pg.token == "" {
+			return
+		}
+	}
+}
+
+// nil-safe: lists the next page in place when not prefetching
+func (pgr *scrPager) next(bck cmn.Bck, lsmsg *apc.LsoMsg, lsargs api.ListArgs) scrPage {
+	if pgr == nil {
+		lst, err := api.ListObjectsPage(apiBP, bck, lsmsg, lsargs)
+		return scrPage{lst: lst, err: err, token: lsmsg.ContinuationToken}
+	}
+	return <-pgr.ch
+}
+
+func (pgr *scrPager) close() { close(pgr.stop) }
+
+// '--max-list-rate' and friends: sleep while ahead of the rate (checking for SIGINT)
+func (ctx *scrCtx) throttle(rate *scrRate, n int) {
+	sleep := max(rate.reserve(n), ctx.rateTotal.reserve(n))
+	for sleep > 0 && !ctx.stopped.Load() {
+		d := min(sleep, 100*time.Millisecond)
+		time.Sleep(d)
+		sleep -= d
+	}
+}
+
 func (ctx *scrCtx) progress(scr *scrBp, listed int64, yes *bool) {
+	ctx.tui.upd(scr)
 	var (
 		now  = mono.NanoTime()
 		last = ctx.last.Load()
@@ -434,31 +2126,165 @@ func (ctx *scrCtx) progress(scr *scrBp, listed int64, yes *bool) {
 		sb.WriteUint8(' ')
 	}
 
-	fmt.Fprintf(ctx.c.App.Writer, "\r%s", sb.String())
-	*yes = true
+	if ctx.tui == nil {
+		fmt.Fprintf(ctx.infoW(), "\r%s", sb.String())
+		*yes = true
+	}
+
+	if err := ctx.status.write(ctx); err != nil {
+		fmt.Fprintf(ctx.infoW(), "\n%s: %v (disabling)\n", qflprn(scrubStatusFileFlag), err)
+		ctx.status.disable()
+	}
+}
+
+//
+// '--status-file': JSON snapshot for external monitoring (nil-safe)
+// - each bucket's goroutine updates its own entry (copying counters) once per page
+// - written at progress intervals (see ctx.progress) and upon completion, via jsp.Save (temp file + rename)
+//
+
+type (
+	scrStatus struct {
+		snap scrStatusSnap
+		m    map[string]*scrStatusBck // by bucket[/prefix]
+		fn   string
+		mu   sync.Mutex
+	}
+	scrStatusSnap struct {
+		Started time.Time       `json:"started"`
+		Updated time.Time       `json:"updated"`
+		Names   int64           `json:"names"` // total listed (all buckets)
+		Done    bool            `json:"done"`
+		Buckets []*scrStatusBck `json:"buckets"`
+	}
+	scrStatusBck struct {
+		Stats             map[string]teb.CntSiz `json:"stats"` // non-zero, by (lowercase) column name
+		Bucket            string                `json:"bucket"`
+		ContinuationToken string                `json:"continuation_token,omitempty"` // next page, if any
+		Names             int64                 `json:"names"`
+		Pages             int                   `json:"pages"`
+		Done              bool                  `json:"done"`
+		Partial           bool                  `json:"partial,omitempty"`
+	}
+)
+
+func newScrStatus(fn string) *scrStatus {
+	return &scrStatus{
+		fn:   fn,
+		m:    make(map[string]*scrStatusBck, 4),
+		snap: scrStatusSnap{Started: time.Now(), Buckets: []*scrStatusBck{}},
+	}
+}
+
+func (st *scrStatus) upd(scr *scrBp, token string, done bool) {
+	if st == nil {
+		return
+	}
+	name := scr.Bck.Cname(scr.Prefix)
+	st.mu.Lock()
+	b, ok := st.m[name]
+	if !ok {
+		b = &scrStatusBck{Bucket: name}
+		st.m[name] = b
+		st.snap.Buckets = append(st.snap.Buckets, b)
+	}
+	if !done {
+		b.Pages++
+	}
+	b.Stats = make(map[string]teb.CntSiz, 4)
+	for i := range scr.Stats {
+		if scr.Stats[i].Cnt != 0 {
+			b.Stats[strings.ToLower(teb.ScrCols[i])] = scr.Stats[i]
+		}
+	}
+	b.Names, b.ContinuationToken, b.Done, b.Partial = scr.Names, token, done, scr.Partial
+	st.mu.Unlock()
+}
+
+func (st *scrStatus) write(ctx *scrCtx) error {
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.fn == "" {
+		return nil // disabled
+	}
+	st.snap.Updated = time.Now()
+	st.snap.Names = ctx.total.Load()
+	return jsp.Save(st.fn, &st.snap, jsp.Plain(), nil)
+}
+
+func (st *scrStatus) disable() {
+	st.mu.Lock()
+	st.fn = ""
+	st.mu.Unlock()
+}
+
+// final
+func (st *scrStatus) fin(ctx *scrCtx) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	st.snap.Done = true
+	st.mu.Unlock()
+	if err := st.write(ctx); err != nil {
+		actionWarn(ctx.c, qflprn(scrubStatusFileFlag)+": "+err.Error())
+	}
 }
 
 ///////////
 // scrBp //
 ///////////
 
-func (scr *scrBp) upd(parent *scrCtx, en *cmn.LsoEnt) {
+func (ctx *scrCtx) excluded(name string) bool {
+	for _, p := range ctx.excl {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns true when the entry is in-cluster and (properly) located - i.e., subject to
+// '--deep' and '--schema' verification (see scr.deep and scr.validate)
+func (scr *scrBp) upd(parent *scrCtx, en *cmn.LsoEnt) (verify bool) {
+	if parent.excluded(en.Name) {
+		scr.Stats[teb.ScrExcluded].Cnt++
+		scr.Stats[teb.ScrExcluded].Siz += en.Size
+		return false
+	}
 	scr.Stats[teb.ScrObjects].Cnt++
 	scr.Stats[teb.ScrObjects].Siz += en.Size
+	parent.top.add(scr.Cname, en)
+
+	if parent.namePolicy != nil && !parent.namePolicy.MatchString(en.Name) {
+		scr.Stats[teb.ScrBadName].Cnt++
+		scr.Stats[teb.ScrBadName].Siz += en.Size
+		scr.log(parent, en, teb.ScrBadName)
+	}
 
 	if !en.IsPresent() {
 		scr.Stats[teb.ScrNotIn].Cnt++
 		scr.Stats[teb.ScrNotIn].Siz += en.Size
 		scr.log(parent, en, teb.ScrNotIn)
+		parent.pf.add(scr, en)
 		// no further checking
-		return
+		return false
+	}
+	if parent.pendingDel && scr.pendingDel(en) {
+		scr.Stats[teb.ScrPendingDel].Cnt++
+		scr.Stats[teb.ScrPendingDel].Siz += en.Size
+		scr.log(parent, en, teb.ScrPendingDel)
 	}
 	if en.Status() == apc.LocMisplacedNode {
 		scr.Stats[teb.ScrMisplacedNode].Cnt++
 		scr.Stats[teb.ScrMisplacedNode].Siz += en.Size
 		scr.log(parent, en, teb.ScrMisplacedNode)
+		parent.byLoc(en, teb.ScrMisplacedNode)
 		// no further checking
-		return
+		return false
 	}
 
 	// or-ing rest conditions (x num-copies)
@@ -466,11 +2292,30 @@ func (scr *scrBp) upd(parent *scrCtx, en *cmn.LsoEnt) {
 		scr.Stats[teb.ScrMisplacedMpath].Cnt++
 		scr.Stats[teb.ScrMisplacedMpath].Siz += en.Size
 		scr.log(parent, en, teb.ScrMisplacedMpath)
+		parent.byLoc(en, teb.ScrMisplacedMpath)
+		parent.scriptResilver(en)
 	}
 
 	if scr.Bck.Props.Mirror.Enabled && en.Copies < int16(scr.Bck.Props.Mirror.Copies) {
 		scr.Stats[teb.ScrMissingCp].Cnt++
 		scr.log(parent, en, teb.ScrMissingCp)
+		parent.byLoc(en, teb.ScrMissingCp)
+	}
+
+	if en.Size == 0 {
+		parent.scriptRm(scr, en)
+		scr.zero(parent, en)
+	}
+
+	if parent.noCksum && en.Checksum == "" {
+		scr.Stats[teb.ScrNoCksum].Cnt++
+		scr.Stats[teb.ScrNoCksum].Siz += en.Size
+		scr.log(parent, en, teb.ScrNoCksum)
+	}
+
+	if parent.dupes != nil && en.Checksum != "" && parent.dupes.add(scr.Cname, en) {
+		scr.Stats[teb.ScrDupContent].Cnt++
+		scr.Stats[teb.ScrDupContent].Siz += en.Size
 	}
 
 	if en.Size <= parent.small {
@@ -484,14 +2329,87 @@ func (scr *scrBp) upd(parent *scrCtx, en *cmn.LsoEnt) {
 	}
 
 	if en.IsAnyFlagSet(apc.EntryVerChanged) {
-		scr.Stats[teb.ScrVchanged].Cnt++
-		scr.Stats[teb.ScrVchanged].Siz += en.Size
-		scr.log(parent, en, teb.ScrVchanged)
+		if !scr.skipVC() { // otherwise, not verifiable
+			scr.Stats[teb.ScrVchanged].Cnt++
+			scr.Stats[teb.ScrVchanged].Siz += en.Size
+			scr.log(parent, en, teb.ScrVchanged)
+		}
 	} else if en.IsAnyFlagSet(apc.EntryVerRemoved) {
 		scr.Stats[teb.ScrVremoved].Cnt++
 		scr.Stats[teb.ScrVremoved].Siz += en.Size
 		scr.log(parent, en, teb.ScrVremoved)
 	}
+	return true
+}
+
+// '--deep': HEAD and compare (one page at a time)
+func (scr *scrBp) deep(parent *scrCtx, ens []*cmn.LsoEnt) {
+	headAll(ens, parent.numWorkers, scr.metaEq, func(en *cmn.LsoEnt) {
+		scr.Stats[teb.ScrMetaMismatch].Cnt++
+		scr.Stats[teb.ScrMetaMismatch].Siz += en.Size
+		scr.log(parent, en, teb.ScrMetaMismatch)
+	})
+}
+
+// run `check` for all entries using a bounded number of workers;
+// calls to `mismatch` are serialized
+func headAll(ens []*cmn.LsoEnt, numWorkers int, check func(*cmn.LsoEnt) bool, mismatch func(*cmn.LsoEnt)) {
+	if numWorkers <= 1 || len(ens) == 1 {
+		for _, en := range ens {
+			if !check(en) {
+				mismatch(en)
+			}
+		}
+		return
+	}
+	var (
+		wg = cos.NewLimitedWaitGroup(numWorkers, len(ens))
+		mu sync.Mutex
+	)
+	for _, en := range ens {
+		wg.Add(1)
+		go func(en *cmn.LsoEnt) {
+			if !check(en) {
+				mu.Lock()
+				mismatch(en)
+				mu.Unlock()
+			}
+			wg.Done()
+		}(en)
+	}
+	wg.Wait()
+}
+
+// bucket (feature flag) intentionally skips loading version and checksum -
+// nothing to compare against
+func (scr *scrBp) skipVC() bool {
+	return scr.Bck.Props != nil && scr.Bck.Props.Features.IsSet(feat.SkipVC)
+}
+
+// '--pending-delete': listed but not "live"
+// - in-cluster replica of an object that was deleted remotely (and not yet evicted)
+// - a copy whose main replica is missing (deleted, not yet cleaned up)
+func (*scrBp) pendingDel(en *cmn.LsoEnt) bool {
+	return en.IsAnyFlagSet(apc.EntryVerRemoved) || en.Status() == apc.LocIsCopyMissingObj
+}
+
+// '--deep': compare listed entry with the object's stored metadata
+// (failure to load the latter counts as inconsistency as well)
+func (scr *scrBp) metaEq(en *cmn.LsoEnt) bool {
+	op, err := api.HeadObject(apiBP, scr.Bck, en.Name, api.HeadArgs{FltPresence: apc.FltPresent, Silent: true})
+	if err != nil {
+		return false
+	}
+	if op.Size != en.Size {
+		return false
+	}
+	if scr.skipVC() {
+		return true // size only
+	}
+	if en.Checksum != "" && op.Cksum != nil && op.Cksum.Value() != en.Checksum {
+		return false
+	}
+	return en.Version == "" || op.Version() == en.Version
 }
 
 // NOTE: exit upon (unlikely) failure
@@ -522,6 +2440,14 @@ func (scr *scrBp) log(parent *scrCtx, en *cmn.LsoEnt, i int) {
 	if parent.numBcks > 1 {
 		log.mu.Unlock()
 	}
+
+	if parent.outf.fh != nil {
+		parent.outf.issue(scr, en, log.tag)
+	}
+	if parent.stream != nil {
+		parent.stream.emit(scr, en, log.tag)
+	}
+	parent.tui.flag(scr, en, log.tag)
 }
 
 func (scr *scrBp) cname(objname string) {
@@ -550,6 +2476,25 @@ func (log *_log) dflt(scr *scrBp, en *cmn.LsoEnt) {
 	log.cnt++
 }
 
+// logTitleOutFile = "Issue,Name,Size"
+func (log *_log) issue(scr *scrBp, en *cmn.LsoEnt, tag string) {
+	log.mu.Lock()
+	sb := &scr.Line
+	sb.Reset(logMaxLn, true)
+	sb.WriteUint8('"')
+	sb.WriteString(tag)
+	sb.WriteString(logDelim)
+
+	scr.cname(en.Name)
+
+	sb.WriteString(logDelim)
+	sb.WriteString(strconv.FormatInt(en.Size, 10))
+	sb.WriteUint8('"')
+	fmt.Fprintln(log.fh, sb.String())
+	log.cnt++
+	log.mu.Unlock()
+}
+
 // logTitleVerChanged = "Name,Size,Custom"
 func (log *_log) vchanged(scr *scrBp, en *cmn.LsoEnt) {
 	sb := &scr.Line
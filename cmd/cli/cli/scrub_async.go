@@ -0,0 +1,216 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+// '--async': scrub in the background and return job ID right away
+// - the background (detached) process runs the same command line minus '--async', plus:
+//   '--status-file' (progress), '--json' (results - unless '--output' or '--template'), and '--yes' (no stdin)
+// - job handle and files: <config-dir>/scrub/<job-ID>{.json, .status.json, .result, .log}
+// - 'ais show job <job-ID>' shows the state and per-bucket progress or, once done, the results
+// - the handle stays until removed (no cleanup)
+
+const (
+	scrJobPrefix = "scrub-"
+	scrJobDir    = "scrub"
+)
+
+type scrJob struct {
+	Started time.Time `json:"started"`
+	ID      string    `json:"id"`
+	Args    []string  `json:"args"`
+	Status  string    `json:"status_file"`
+	Result  string    `json:"result_file"`
+	Log     string    `json:"log_file"`
+	PID     int       `json:"pid"`
+}
+
+func isScrJobID(id string) bool {
+	return strings.HasPrefix(id, scrJobPrefix) && len(id) > len(scrJobPrefix)
+}
+
+func scrJobHandle(id string) string { return filepath.Join(config.ConfigDir, scrJobDir, id+".json") }
+
+func newScrJob(id string) *scrJob {
+	pref := filepath.Join(config.ConfigDir, scrJobDir, id)
+	return &scrJob{
+		ID:      id,
+		Started: time.Now(),
+		Status:  pref + ".status.json",
+		Result:  pref + ".result",
+		Log:     pref + ".log",
+	}
+}
+
+// the same command line minus '--async', plus (see above)
+func scrAsyncArgs(args []string, job *scrJob, results bool) []string {
+	out := make([]string, 0, len(args)+5)
+	for _, arg := range args {
+		if name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "="); strings.HasPrefix(arg, "-") && name == scrubAsyncFlag.Name {
+			continue
+		}
+		out = append(out, arg)
+	}
+	out = append(out, flprn(scrubStatusFileFlag), job.Status, flprn(yesFlag))
+	if results {
+		out = append(out, flprn(jsonFlag))
+	}
+	return out
+}
+
+func (ctx *scrCtx) async() error {
+	c := ctx.c
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	job := newScrJob(scrJobPrefix + cos.CryptoRandS(8))
+	if err := cos.CreateDir(filepath.Dir(job.Status)); err != nil {
+		return err
+	}
+	results := !flagIsSet(c, scrubOutputFlag) && !flagIsSet(c, scrubTemplateFlag)
+	job.Args = scrAsyncArgs(os.Args[1:], job, results)
+
+	fout, err := cos.CreateFile(job.Result)
+	if err != nil {
+		return err
+	}
+	defer fout.Close()
+	ferr, err := cos.CreateFile(job.Log)
+	if err != nil {
+		return err
+	}
+	defer ferr.Close()
+
+	cmd := exec.Command(exe, job.Args...)
+	cmd.Stdout, cmd.Stderr = fout, ferr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true} // survive the terminal
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("%s: failed to start: %v", qflprn(scrubAsyncFlag), err)
+	}
+	job.PID = cmd.Process.Pid
+	cmd.Process.Release()
+
+	if err := jsp.Save(scrJobHandle(job.ID), job, jsp.Plain(), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Started scrub %s in the background (to monitor, run 'ais show job %s').\n", job.ID, job.ID)
+	return nil
+}
+
+//
+// 'ais show job <scrub-ID>'
+//
+
+func showScrubJob(c *cli.Context, id string) error {
+	var (
+		job  scrJob
+		snap scrStatusSnap
+		fn   = scrJobHandle(id)
+	)
+	if _, err := jsp.Load(fn, &job, jsp.Plain()); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("scrub job %q not found (no such handle %q)", id, fn)
+		}
+		return err
+	}
+	_, errS := jsp.Load(job.Status, &snap, jsp.Plain())
+
+	// (signal 0: check existence; assuming no PID reuse)
+	state := "running"
+	switch {
+	case errS == nil && snap.Done:
+		state = "finished"
+	case syscall.Kill(job.PID, 0) != nil:
+		state = "terminated (see log)"
+	}
+	w := c.App.Writer
+	fmt.Fprintf(w, "%s: %s (pid %d, started %s)\n", job.ID, state, job.PID, job.Started.Format(time.Stamp))
+	fmt.Fprintln(w, "Command:", "ais", strings.Join(job.Args, " "))
+
+	switch {
+	case errS == nil && snap.Done:
+		if err := prntScrJobResult(c, &job); err != nil {
+			actionWarn(c, err.Error())
+		}
+	case errS == nil:
+		fmt.Fprintf(w, "Listed %s names (as of %s):\n", cos.FormatBigI64(snap.Names), snap.Updated.Format(time.Stamp))
+		for _, b := range snap.Buckets {
+			fmt.Fprintln(w, indent1+b.prnt())
+		}
+	case !os.IsNotExist(errS):
+		actionWarn(c, errS.Error())
+	}
+	fmt.Fprintln(w, "Log:", job.Log)
+	return nil
+}
+
+func prntScrJobResult(c *cli.Context, job *scrJob) error {
+	b, err := os.ReadFile(job.Result)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return errors.New("no results (see log)")
+	}
+	var all []*teb.ScrBp
+	if err := jsoniter.Unmarshal(b, &all); err != nil {
+		// (not '--json' - e.g., '--output=csv')
+		fmt.Fprintln(c.App.Writer, strings.TrimSpace(string(b)))
+		return nil
+	}
+	if len(all) == 0 {
+		return errors.New("no results (see log)")
+	}
+	var haveRemote bool
+	for _, scr := range all {
+		haveRemote = haveRemote || scr.Bck.IsRemote()
+	}
+	h := teb.ScrubHelper{All: all}
+	tab := h.MakeTab("", haveRemote, false)
+	return teb.Print(all, tab.Template(false))
+}
+
+func (b *scrStatusBck) prnt() string {
+	var (
+		sb    strings.Builder
+		state = "listing"
+	)
+	switch {
+	case b.Partial:
+		state = "partial"
+	case b.Done:
+		state = "done"
+	}
+	fmt.Fprintf(&sb, "%s: %s names, %d page(s), %s", b.Bucket, cos.FormatBigI64(b.Names), b.Pages, state)
+	for i, col := range teb.ScrCols {
+		if i == teb.ScrObjects {
+			continue
+		}
+		if cs, ok := b.Stats[strings.ToLower(col)]; ok && cs.Cnt != 0 {
+			fmt.Fprintf(&sb, ", %s: %d", strings.ToLower(col), cs.Cnt)
+		}
+	}
+	return sb.String()
+}
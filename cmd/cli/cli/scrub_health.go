@@ -0,0 +1,130 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// '--by-location': label location rows that sit on a known-bad target or mountpath,
+// so that a misplaced (or missing-copies) spike gets attributed to the disk rather than data:
+// - current state (at the end of the scrub) - not necessarily the state at the time of writing
+// - best effort: failure to query a given target leaves its rows unlabeled
+
+const (
+	locNoTarget = "(target not in cluster map)"
+	locMaint    = "(target in maintenance)"
+	locDisabled = "(mountpath disabled)"
+	locDetached = "(mountpath detached)"
+	locWaitDD   = "(mountpath being disabled or detached)"
+	locDegraded = "(mountpath degraded: %s)"
+)
+
+type tgtHealth struct {
+	tsi  *meta.Snode
+	mpl  *apc.MountpathList
+	tcdf *fs.Tcdf
+}
+
+// location = "t[ID]:mp[/path, ...]" (see core.LOM.Location)
+func parseLoc(loc string) (tid, mpath string) {
+	tname, mp, ok := strings.Cut(loc, apc.LocationPropSepa)
+	if !ok {
+		return "", ""
+	}
+	if strings.HasPrefix(tname, "t[") && strings.HasSuffix(tname, "]") {
+		tid = tname[2 : len(tname)-1]
+	}
+	if s, ok := strings.CutPrefix(mp, "mp["); ok {
+		if i := strings.IndexAny(s, ",]"); i > 0 {
+			mpath = s[:i]
+		}
+	}
+	return tid, mpath
+}
+
+// empty when healthy (or unknown)
+func (h *tgtHealth) note(mpath string) string {
+	switch {
+	case h.tsi == nil:
+		return locNoTarget
+	case h.tsi.InMaintOrDecomm():
+		return locMaint
+	case h.mpl == nil || mpath == "":
+		return ""
+	case slices.Contains(h.mpl.Disabled, mpath):
+		return locDisabled
+	case slices.Contains(h.mpl.WaitingDD, mpath):
+		return locWaitDD
+	case !slices.Contains(h.mpl.Available, mpath):
+		return locDetached
+	}
+	if h.tcdf == nil {
+		return ""
+	}
+	if cdf, ok := h.tcdf.Mountpaths[mpath]; ok {
+		if alert, _ := fs.HasAlert(cdf.Disks); alert != "" {
+			return fmt.Sprintf(locDegraded, strings.Trim(alert, "()"))
+		}
+	}
+	return ""
+}
+
+// returns the number of labeled rows
+func (ctx *scrCtx) locHealth(locs teb.ScrLocs) (n int) {
+	smap, err := getClusterMap(ctx.c)
+	if err != nil {
+		actionWarn(ctx.c, "failed to get cluster map (mountpath health unknown): "+err.Error())
+		return 0
+	}
+	var (
+		health = make(map[string]*tgtHealth, len(smap.Tmap))
+		wg     sync.WaitGroup
+	)
+	for _, loc := range locs {
+		tid, _ := parseLoc(loc.Location)
+		if tid == "" {
+			continue
+		}
+		if _, ok := health[tid]; ok {
+			continue
+		}
+		h := &tgtHealth{tsi: smap.GetTarget(tid)}
+		health[tid] = h
+		if h.tsi == nil || h.tsi.InMaintOrDecomm() {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if mpl, err := api.GetMountpaths(apiBP, h.tsi); err == nil {
+				h.mpl = mpl
+			}
+			if ds, err := api.GetStatsAndStatus(apiBP, h.tsi); err == nil {
+				h.tcdf = &ds.Tcdf
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, loc := range locs {
+		tid, mpath := parseLoc(loc.Location)
+		if h, ok := health[tid]; ok {
+			if loc.Note = h.note(mpath); loc.Note != "" {
+				n++
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,146 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/memsys"
+
+	"github.com/urfave/cli"
+)
+
+// '--history-bucket': upon completion, store the run's summary in the specified bucket
+// - one object per run: scrHistPrefix + UTC timestamp (lexicographic order == chronological)
+// - jsp-encoded with signature and checksum (see scrHistVer)
+// - the record carries cluster UUID and scrub parameters (positional arguments and flags that were set)
+// - unless '--compare-to' is specified, the run gets compared with the most recent record (if any)
+// - interrupted runs are stored as well (and marked partial)
+
+const (
+	scrHistPrefix = "scrub-history/"
+	scrHistSuffix = ".jsp"
+	scrHistFormat = "20060102-150405"
+	scrHistVer    = 1
+)
+
+type (
+	scrHistRec struct {
+		Started  time.Time         `json:"started"`
+		Finished time.Time         `json:"finished"`
+		UUID     string            `json:"cluster_uuid"`
+		Args     []string          `json:"args"`
+		Flags    map[string]string `json:"flags,omitempty"`
+		Results  []*teb.ScrBp      `json:"results"`
+		Partial  bool              `json:"partial,omitempty"`
+	}
+	scrHist struct {
+		bck     cmn.Bck
+		started time.Time
+		mm      *memsys.MMSA
+	}
+)
+
+func newScrHist(c *cli.Context) (*scrHist, error) {
+	bck, err := parseBckURI(c, parseStrFlag(c, scrubHistoryBucketFlag), true /*error only*/)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", qflprn(scrubHistoryBucketFlag), err)
+	}
+	return &scrHist{bck: bck, started: time.Now(), mm: memsys.NewMMSA("cli-scrub-history", true /*silent*/)}, nil
+}
+
+func scrHistName(t time.Time) string {
+	return scrHistPrefix + t.UTC().Format(scrHistFormat) + scrHistSuffix
+}
+
+func scrHistLatest(entries cmn.LsoEntries) (latest string) {
+	for _, en := range entries {
+		if strings.HasSuffix(en.Name, scrHistSuffix) && en.Name > latest {
+			latest = en.Name
+		}
+	}
+	return latest
+}
+
+func (ctx *scrCtx) histRec() *scrHistRec {
+	c := ctx.c
+	rec := &scrHistRec{
+		Started:  ctx.hist.started,
+		Finished: time.Now(),
+		Args:     c.Args(),
+		Flags:    make(map[string]string, 8),
+		Results:  make([]*teb.ScrBp, len(ctx.scrubs)),
+		Partial:  ctx.stopped.Load(),
+	}
+	if smap, err := getClusterMap(c); err == nil {
+		rec.UUID = smap.UUID
+	}
+	for _, name := range c.FlagNames() {
+		if c.IsSet(name) {
+			rec.Flags[name] = c.String(name)
+		}
+	}
+	for i, scr := range ctx.scrubs {
+		rec.Results[i] = (*teb.ScrBp)(scr)
+	}
+	return rec
+}
+
+func (ctx *scrCtx) storeHist() error {
+	var (
+		h    = ctx.hist
+		rec  = ctx.histRec()
+		name = scrHistName(rec.Finished)
+		sgl  = h.mm.NewSGL(0)
+	)
+	defer sgl.Free()
+	if err := jsp.Encode(sgl, rec, jsp.CksumSign(scrHistVer)); err != nil {
+		return err
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        h.bck,
+		ObjName:    name,
+		Reader:     sgl,
+		Size:       uint64(sgl.Size()),
+	}
+	if _, err := api.PutObject(&putArgs); err != nil {
+		return fmt.Errorf("%s: failed to store %s: %v", qflprn(scrubHistoryBucketFlag), h.bck.Cname(name), V(err))
+	}
+	fmt.Fprintln(ctx.infoW(), "Stored scrub summary:", h.bck.Cname(name))
+	return nil
+}
+
+// the most recent record, if any
+func (h *scrHist) latest() (rec *scrHistRec, cname string, _ error) {
+	msg := &apc.LsoMsg{Prefix: scrHistPrefix, Props: apc.GetPropsName}
+	msg.SetFlag(apc.LsNameOnly)
+	lst, err := api.ListObjects(apiBP, h.bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, h.bck.Cname(scrHistPrefix), V(err)
+	}
+	name := scrHistLatest(lst.Entries)
+	if name == "" {
+		return nil, "", nil
+	}
+	sgl := h.mm.NewSGL(0)
+	defer sgl.Free()
+	cname = h.bck.Cname(name)
+	if _, err := api.GetObject(apiBP, h.bck, name, &api.GetArgs{Writer: sgl}); err != nil {
+		return nil, cname, V(err)
+	}
+	rec = &scrHistRec{}
+	if _, err := jsp.Decode(sgl, rec, jsp.CksumSign(scrHistVer), cname); err != nil {
+		return nil, cname, err
+	}
+	return rec, cname, nil
+}
@@ -0,0 +1,549 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	"github.com/urfave/cli"
+)
+
+// run with '-race'
+func TestScrubHeadAll(t *testing.T) {
+	const num = 1000
+	ens := make([]*cmn.LsoEnt, num)
+	for i := range ens {
+		ens[i] = &cmn.LsoEnt{Name: "o" + strconv.Itoa(i), Size: int64(i)}
+	}
+	for _, numWorkers := range []int{0, 1, 4, 64} {
+		var (
+			checked atomic.Int64
+			cnt     int   // no locking: relies on headAll to serialize `mismatch`
+			siz     int64 // ditto
+		)
+		check := func(en *cmn.LsoEnt) bool {
+			checked.Inc()
+			return en.Size%3 != 0
+		}
+		mismatch := func(en *cmn.LsoEnt) {
+			cnt++
+			siz += en.Size
+		}
+		headAll(ens, numWorkers, check, mismatch)
+
+		var expCnt int
+		var expSiz int64
+		for i := 0; i < num; i += 3 {
+			expCnt++
+			expSiz += int64(i)
+		}
+		tassert.Errorf(t, checked.Load() == num, "workers %d: checked %d, expected %d", numWorkers, checked.Load(), num)
+		tassert.Errorf(t, cnt == expCnt, "workers %d: mismatch count %d, expected %d", numWorkers, cnt, expCnt)
+		tassert.Errorf(t, siz == expSiz, "workers %d: mismatch size %d, expected %d", numWorkers, siz, expSiz)
+	}
+}
+
+func TestScrubSchema(t *testing.T) {
+	const schema = `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id", "tags"],
+		"additionalProperties": false,
+		"properties": {
+			"id":    {"type": "integer", "minimum": 1},
+			"name":  {"type": "string", "minLength": 1, "pattern": "^[a-z]+$"},
+			"kind":  {"enum": ["a", "b"]},
+			"tags":  {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+			"score": {"type": ["number", "null"]}
+		}
+	}`
+	var v any
+	tassert.CheckFatal(t, json.Unmarshal([]byte(schema), &v))
+	root, err := compileSchema(v, "#")
+	tassert.CheckFatal(t, err)
+
+	tests := []struct {
+		doc string
+		bad string // expected error substring (empty: valid)
+	}{
+		{`{"id": 1, "tags": []}`, ""},
+		{`{"id": 7, "name": "abc", "kind": "b", "tags": ["x", "y"], "score": 0.5}`, ""},
+		{`{"id": 7, "tags": [], "score": null}`, ""},
+		{`{"tags": []}`, `missing required property "id"`},
+		{`{"id": 1.5, "tags": []}`, "/id: expecting integer"},
+		{`{"id": 0, "tags": []}`, "less than minimum"},
+		{`{"id": 1, "tags": [], "name": "ABC"}`, "does not match pattern"},
+		{`{"id": 1, "tags": [], "kind": "c"}`, "/kind: not one of enum values"},
+		{`{"id": 1, "tags": ["x", 2]}`, "/tags/1: expecting string, got integer"},
+		{`{"id": 1, "tags": ["x", "y", "z"]}`, "at most 2 items"},
+		{`{"id": 1, "tags": [], "extra": true}`, `unexpected property "extra"`},
+		{`[1, 2]`, "/: expecting object, got array"},
+	}
+	for _, test := range tests {
+		dec := json.NewDecoder(strings.NewReader(test.doc))
+		dec.UseNumber()
+		var doc any
+		tassert.CheckFatal(t, dec.Decode(&doc))
+		err := root.validate(doc, "")
+		switch {
+		case test.bad == "":
+			tassert.Errorf(t, err == nil, "%s: unexpected error: %v", test.doc, err)
+		case err == nil:
+			t.Errorf("%s: expected error %q", test.doc, test.bad)
+		default:
+			tassert.Errorf(t, strings.Contains(err.Error(), test.bad), "%s: expected %q, got %q", test.doc, test.bad, err)
+		}
+	}
+
+	// unsupported keywords are rejected (no silent passes)
+	tassert.CheckFatal(t, json.Unmarshal([]byte(`{"properties": {"a": {"anyOf": [{"type": "string"}]}}}`), &v))
+	_, err = compileSchema(v, "#")
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "anyOf"), "expected unsupported keyword error, got %v", err)
+}
+
+func TestScrubTop(t *testing.T) {
+	const n = 5
+	top := &scrTop{n: n}
+	for _, i := range rand.Perm(1000) {
+		top.add("ais://b", &cmn.LsoEnt{Name: "o" + strconv.Itoa(i), Size: int64(i)})
+	}
+	ens := top.sorted()
+	tassert.Fatalf(t, len(ens) == n, "expected %d entries, got %d", n, len(ens))
+	for i, en := range ens {
+		exp := int64(999 - i)
+		tassert.Errorf(t, en.size == exp, "position %d: expected size %d, got %d (%s)", i, exp, en.size, en.name)
+	}
+}
+
+func TestScrubFailFast(t *testing.T) {
+	for _, s := range []string{"misplaced", "misplaced=-1", "no-such=1", "misplaced=1,"} {
+		_, err := parseFailFast(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+	ff, err := parseFailFast("misplaced=2, missing-copies=0")
+	tassert.CheckFatal(t, err)
+
+	scr := &scrBp{Cname: "ais://b"}
+	scr.Stats[teb.ScrMisplacedNode].Cnt = 1
+	scr.Stats[teb.ScrMisplacedMpath].Cnt = 1
+	tassert.Fatalf(t, !ff.check(scr), "misplaced 2 <= 2: not expecting exceeded")
+	scr.Stats[teb.ScrMisplacedMpath].Cnt = 2
+	tassert.Fatalf(t, ff.check(scr), "misplaced 3 > 2: expecting exceeded")
+	tassert.Fatalf(t, len(ff.exceeded) == 1 && strings.HasPrefix(ff.exceeded[0], "ais://b: misplaced 3"),
+		"unexpected %v", ff.exceeded)
+
+	var nilff *scrFailFast
+	tassert.Errorf(t, !nilff.check(scr), "nil: expecting no-op")
+}
+
+func TestScrubOnIssue(t *testing.T) {
+	for _, s := range []string{"", "  ", "/no/such/command --arg"} {
+		_, err := parseOnIssue(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+	app := cli.NewApp()
+	app.Writer, app.ErrWriter = io.Discard, io.Discard
+	ctx := &scrCtx{c: cli.NewContext(app, nil, nil)}
+
+	argv, err := parseOnIssue("false --ignored 'not; a shell'")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(argv) == 5 && argv[2] == "'not;", "expected 5 args as is, got %q", argv)
+	ctx.onIssue = argv
+
+	scr := &scrBp{Cname: "ais://b"}
+	scr.Stats[teb.ScrObjects].Cnt = 100
+	scr.Stats[teb.ScrLargeSz].Cnt = 1
+	ctx.scrubs = []*scrBp{scr}
+	tassert.Fatalf(t, ctx.runOnIssue() == nil, "no issues: not expecting the command to run")
+
+	scr.Stats[teb.ScrMissingCp].Cnt = 1
+	err = ctx.runOnIssue()
+	tassert.Fatalf(t, err != nil && strings.Contains(err.Error(), "exited with code 1"), "expected exit code 1, got %v", err)
+}
+
+func TestScrubEmptyDirs(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	var (
+		ctx = &scrCtx{}
+		scr = &scrBp{Cname: "ais://b"}
+		eds = &scrEmptyDirs{}
+	)
+	ctx.iniLogs()
+	defer func() {
+		if fh := ctx.logs[teb.ScrEmptyDir].fh; fh != nil {
+			fh.Close()
+		}
+	}()
+	// sorted, as listed; empty: a/b/, a/c/, a/c/d/, e/
+	names := []string{"a/", "a/b/", "a/c/", "a/c/d/", "a/x", "e/", "f/", "f/g/", "f/g/h", "z"}
+	for _, name := range names {
+		en := &cmn.LsoEnt{Name: name}
+		if isScrDir(en) {
+			eds.push(ctx, scr, en)
+		} else {
+			eds.child(ctx, scr, en)
+		}
+	}
+	eds.flush(ctx, scr, "")
+	tassert.Errorf(t, scr.Stats[teb.ScrEmptyDir].Cnt == 4, "expected 4 empty dirs, got %d", scr.Stats[teb.ScrEmptyDir].Cnt)
+	tassert.Errorf(t, len(eds.stack) == 0, "expected empty stack, got %d", len(eds.stack))
+
+	// trailing empty dir (last page)
+	scr = &scrBp{Cname: "ais://b"}
+	eds.push(ctx, scr, &cmn.LsoEnt{Name: "y/"})
+	eds.flush(ctx, scr, "")
+	tassert.Errorf(t, scr.Stats[teb.ScrEmptyDir].Cnt == 1, "expected 1 empty dir, got %d", scr.Stats[teb.ScrEmptyDir].Cnt)
+
+	var nileds *scrEmptyDirs
+	nileds.push(ctx, scr, &cmn.LsoEnt{Name: "y/"}) // no-op
+}
+
+func TestScrubSort(t *testing.T) {
+	for _, s := range []string{"no-such", "misplaced:up", ""} {
+		_, err := parseScrSort(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+	mk := func(name string, misplaced, missing int64) *scrBp {
+		scr := &scrBp{Bck: cmn.Bck{Name: name, Provider: "ais"}}
+		scr.Stats[teb.ScrMisplacedNode].Cnt = misplaced
+		scr.Stats[teb.ScrMissingCp].Cnt = missing
+		return scr
+	}
+	tests := []struct {
+		sort string
+		exp  string
+	}{
+		{"misplaced", "b,c,a"},
+		{"missing-copies:asc", "c,a,b"},
+		{"bucket", "a,b,c"},
+		{"bucket:desc", "c,b,a"},
+	}
+	for _, test := range tests {
+		less, err := parseScrSort(test.sort)
+		tassert.CheckFatal(t, err)
+		scrs := []*scrBp{mk("a", 1, 5), mk("b", 9, 7), mk("c", 3, 0)}
+		sort.SliceStable(scrs, func(i, j int) bool { return less(scrs[i], scrs[j]) })
+		names := make([]string, len(scrs))
+		for i, scr := range scrs {
+			names[i] = scr.Bck.Name
+		}
+		got := strings.Join(names, ",")
+		tassert.Errorf(t, got == test.exp, "--sort %s: expected %s, got %s", test.sort, test.exp, got)
+	}
+}
+
+func TestScrubSubtotals(t *testing.T) {
+	mk := func(name, provider, ns string, objs int64) *teb.ScrBp {
+		scr := &teb.ScrBp{Bck: cmn.Bck{Name: name, Provider: provider, Ns: cmn.ParseNsUname(ns)}, Names: objs}
+		scr.Stats[teb.ScrObjects].Cnt = objs
+		return scr
+	}
+	all := teb.ScrubHelper{
+		All: []*teb.ScrBp{
+			mk("a", "ais", "", 1), mk("b", "aws", "", 2), mk("c", "ais", "", 3), mk("d", "ais", "#tenant", 4),
+		},
+		Subtotals: true,
+	}
+	tab := all.MakeTab("", false, false)
+	var lines []string
+	for _, ln := range strings.Split(strings.TrimSpace(tab.Template(true)), "\n") {
+		if strings.HasPrefix(ln, "---") {
+			continue
+		}
+		n := 2 // name, objects
+		if strings.HasPrefix(ln, "SUBTOTAL") {
+			n = 3
+		}
+		lines = append(lines, strings.Join(strings.Fields(ln)[:n], " "))
+	}
+	exp := []string{
+		"ais://a 1", "ais://c 3", "SUBTOTAL ais:// 4",
+		"s3://b 2", "SUBTOTAL s3:// 2",
+		"ais://#tenant/d 4", "SUBTOTAL ais://#tenant 4",
+	}
+	tassert.Fatalf(t, len(lines) == len(exp), "expected %d rows, got %d: %v", len(exp), len(lines), lines)
+	for i := range exp {
+		tassert.Errorf(t, lines[i] == exp[i], "row %d: expected %q, got %q", i, exp[i], lines[i])
+	}
+}
+
+func TestScrubStatusFile(t *testing.T) {
+	var (
+		fn  = filepath.Join(t.TempDir(), "status.json")
+		ctx = &scrCtx{status: newScrStatus(fn)}
+		scr = &scrBp{Bck: cmn.Bck{Name: "b", Provider: "ais"}, Names: 10}
+	)
+	scr.Stats[teb.ScrMisplacedNode] = teb.CntSiz{Cnt: 2, Siz: 20}
+	ctx.total.Store(10)
+	ctx.status.upd(scr, "token-1", false)
+	ctx.status.upd(scr, "token-2", false)
+	tassert.CheckFatal(t, ctx.status.write(ctx))
+
+	var snap scrStatusSnap
+	b, err := os.ReadFile(fn)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, json.Unmarshal(b, &snap))
+	tassert.Fatalf(t, len(snap.Buckets) == 1 && !snap.Done, "unexpected %s", b)
+	sb := snap.Buckets[0]
+	tassert.Errorf(t, sb.Bucket == "ais://b" && sb.Pages == 2 && sb.ContinuationToken == "token-2" && sb.Names == 10, "unexpected %+v", sb)
+	tassert.Errorf(t, len(sb.Stats) == 1 && sb.Stats["misplaced(cluster)"].Cnt == 2, "unexpected stats %v", sb.Stats)
+
+	ctx.status.upd(scr, "", true)
+	ctx.status.fin(ctx)
+	b, err = os.ReadFile(fn)
+	tassert.CheckFatal(t, err)
+	var fin scrStatusSnap
+	tassert.CheckFatal(t, json.Unmarshal(b, &fin))
+	tassert.Errorf(t, fin.Done && fin.Buckets[0].Done && fin.Buckets[0].ContinuationToken == "", "unexpected %s", b)
+}
+
+func TestScrubLocHealth(t *testing.T) {
+	tid, mpath := parseLoc("t[XyZt8081]:mp[/tmp/ais/mp1, nvme0n1, nvme]")
+	tassert.Errorf(t, tid == "XyZt8081" && mpath == "/tmp/ais/mp1", "got %q, %q", tid, mpath)
+	tid, mpath = parseLoc("t[XyZt8081]:mp[/tmp/ais/mp2]")
+	tassert.Errorf(t, tid == "XyZt8081" && mpath == "/tmp/ais/mp2", "got %q, %q", tid, mpath)
+	tid, mpath = parseLoc("garbage")
+	tassert.Errorf(t, tid == "" && mpath == "", "got %q, %q", tid, mpath)
+
+	h := &tgtHealth{
+		tsi: &meta.Snode{DaeID: "XyZt8081"},
+		mpl: &apc.MountpathList{
+			Available: []string{"/mp1", "/mp2"},
+			WaitingDD: []string{"/mp3"},
+			Disabled:  []string{"/mp4"},
+		},
+		tcdf: &fs.Tcdf{Mountpaths: map[string]*fs.CDF{
+			"/mp1": {Disks: []string{"sda"}},
+			"/mp2": {Disks: []string{"sdb" + fs.DiskFault}},
+		}},
+	}
+	for mpath, expected := range map[string]string{
+		"/mp1": "",
+		"/mp2": "(mountpath degraded: faulted)",
+		"/mp3": locWaitDD,
+		"/mp4": locDisabled,
+		"/mp5": locDetached,
+	} {
+		tassert.Errorf(t, h.note(mpath) == expected, "%s: expected %q, got %q", mpath, expected, h.note(mpath))
+	}
+	h.tsi.Flags = meta.SnodeMaint
+	tassert.Errorf(t, h.note("/mp1") == locMaint, "expected %q, got %q", locMaint, h.note("/mp1"))
+	h.tsi = nil
+	tassert.Errorf(t, h.note("/mp1") == locNoTarget, "expected %q, got %q", locNoTarget, h.note("/mp1"))
+
+	// NOTE column: only when there's something to show
+	locs := teb.ScrLocs{{Location: "t[a]:mp[/mp1]"}}
+	tassert.Errorf(t, !strings.Contains(locs.MakeTab("").Template(false), "NOTE"), "expecting no NOTE column")
+	locs[0].Note = locDisabled
+	tassert.Errorf(t, strings.Contains(locs.MakeTab("").Template(false), "NOTE"), "expecting NOTE column")
+}
+
+func TestScrubHistory(t *testing.T) {
+	var (
+		t0  = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		n0  = scrHistName(t0)
+		n1  = scrHistName(t0.Add(time.Second))
+		n2  = scrHistName(t0.Add(24 * time.Hour))
+		ens = cmn.LsoEntries{{Name: n1}, {Name: n2}, {Name: n0}, {Name: scrHistPrefix + "zzz.txt"}}
+	)
+	tassert.Errorf(t, scrHistLatest(ens) == n2, "expecting %q, got %q", n2, scrHistLatest(ens))
+	tassert.Errorf(t, scrHistLatest(nil) == "", "expecting none")
+
+	var (
+		mm  = memsys.NewMMSA("scrub-history-test", true)
+		sgl = mm.NewSGL(0)
+		scr = &teb.ScrBp{Bck: cmn.Bck{Name: "b", Provider: apc.AIS}, Names: 10}
+		rec = &scrHistRec{Started: t0, Finished: t0.Add(time.Minute), UUID: "uuid", Args: []string{"ais://b"},
+			Flags: map[string]string{"deep": "true"}, Results: []*teb.ScrBp{scr}}
+	)
+	defer sgl.Free()
+	scr.Stats[teb.ScrMisplacedNode] = teb.CntSiz{Cnt: 2, Siz: 20}
+	tassert.CheckFatal(t, jsp.Encode(sgl, rec, jsp.CksumSign(scrHistVer)))
+
+	var out scrHistRec
+	_, err := jsp.Decode(sgl, &out, jsp.CksumSign(scrHistVer), n0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, out.UUID == "uuid" && out.Flags["deep"] == "true" && out.Finished.Equal(rec.Finished), "unexpected %+v", out)
+	tassert.Fatalf(t, len(out.Results) == 1, "unexpected results %+v", out.Results)
+	tassert.Errorf(t, out.Results[0].Names == 10 && out.Results[0].Stats[teb.ScrMisplacedNode].Cnt == 2, "unexpected %+v", out.Results[0])
+}
+
+func TestScrubTUI(t *testing.T) {
+	var (
+		ctx = &scrCtx{}
+		tui = &scrTUI{ctx: ctx, bcks: make(map[string]*tuiBck), sortBy: tuiByIssues}
+		a   = &scrBp{Bck: cmn.Bck{Name: "a", Provider: apc.AIS}, Cname: "ais://a", Names: 100}
+		b   = &scrBp{Bck: cmn.Bck{Name: "b", Provider: apc.AIS}, Cname: "ais://b", Names: 10}
+	)
+	b.Stats[teb.ScrMisplacedNode] = teb.CntSiz{Cnt: 2}
+	tui.done(a)
+	tui.upd(b)
+	for i := range tuiMaxFlagged + 5 {
+		tui.flag(b, &cmn.LsoEnt{Name: "o" + strconv.Itoa(i)}, "misplaced")
+	}
+
+	rows := tui.rows()
+	tassert.Fatalf(t, len(rows) == 2 && rows[0].cname == "ais://b", "expecting 'b' (issues) first: %v", rows)
+	tui.key('s') // by names
+	tassert.Errorf(t, tui.rows()[0].cname == "ais://a", "expecting 'a' (names) first")
+	tui.key('c') // hide clean (and done)
+	tassert.Fatalf(t, len(tui.rows()) == 1, "expecting clean 'a' hidden")
+
+	lines := tui.lines(80, 24)
+	tassert.Errorf(t, strings.Contains(lines[len(lines)-1], "ais://b"), "unexpected %q", lines)
+	for _, ln := range lines {
+		tassert.Errorf(t, len([]rune(strings.TrimPrefix(strings.TrimSuffix(ln, tuiReset), tuiReverse))) <= 80, "too wide: %q", ln)
+	}
+
+	// drill down: most recent flagged objects, oldest first
+	tui.key('\r')
+	tassert.Fatalf(t, tui.drill != nil && tui.drill.cname == "ais://b", "expecting drill-down")
+	recent := tui.drill.recent()
+	tassert.Fatalf(t, len(recent) == tuiMaxFlagged, "expecting %d, got %d", tuiMaxFlagged, len(recent))
+	tassert.Errorf(t, recent[0].name == "o5" && recent[len(recent)-1].name == "o"+strconv.Itoa(tuiMaxFlagged+4),
+		"unexpected order: %s ... %s", recent[0].name, recent[len(recent)-1].name)
+	tui.key('b')
+	tassert.Errorf(t, tui.drill == nil, "expecting back")
+	tassert.Errorf(t, tui.key('q'), "expecting quit")
+}
+
+func TestScrubOutput(t *testing.T) {
+	scr := &teb.ScrBp{Bck: cmn.Bck{Name: "b", Provider: apc.AIS}, Names: 10, Elapsed: 2 * time.Second}
+	scr.Stats[teb.ScrMisplacedNode] = teb.CntSiz{Cnt: 2, Siz: 20}
+	tassert.Errorf(t, scrOutKey(teb.ScrMisplacedNode) == "misplaced_cluster", "unexpected %q", scrOutKey(teb.ScrMisplacedNode))
+	_, err := parseScrOutput("xml")
+	tassert.Errorf(t, err != nil, "expecting error")
+
+	var sb strings.Builder
+	tassert.CheckFatal(t, prntScrOutput(&sb, scrOutJSON, []*teb.ScrBp{scr}, false))
+	var recs []*teb.ScrBp // (same as '--json' - see compareTo)
+	tassert.CheckFatal(t, json.Unmarshal([]byte(sb.String()), &recs))
+	tassert.Fatalf(t, len(recs) == 1, "unexpected %+v", recs)
+	tassert.Errorf(t, recs[0].Bck.Cname("") == "ais://b" && recs[0].Elapsed == 2*time.Second &&
+		recs[0].Stats[teb.ScrMisplacedNode].Siz == 20, "unexpected %+v", recs[0])
+
+	var js strings.Builder
+	saved := teb.Writer
+	teb.Writer = &js
+	err = teb.Print([]*teb.ScrBp{scr}, "", teb.Jopts(true))
+	teb.Writer = saved
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, js.String() == sb.String(), "'--output=json' vs '--json':\n%s\nvs\n%s", sb.String(), js.String())
+
+	sb.Reset()
+	tassert.CheckFatal(t, prntScrOutput(&sb, scrOutCSV, []*teb.ScrBp{scr, scr}, false))
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	tassert.Fatalf(t, len(lines) == 3, "expecting header and 2 rows, got %q", lines)
+	hdr, row := strings.Split(lines[0], ","), strings.Split(lines[1], ",")
+	tassert.Fatalf(t, len(hdr) == len(row) && len(hdr) == len(scrOutCols)+2*teb.ScrNumStats, "%d vs %d", len(hdr), len(row))
+	i := len(scrOutCols) + 2*teb.ScrMisplacedNode
+	tassert.Errorf(t, hdr[i] == "misplaced_cluster" && row[i] == "2" && row[i+1] == "20", "unexpected %q: %q", hdr[i], row[i])
+
+	sb.Reset()
+	tassert.CheckFatal(t, prntScrOutput(&sb, scrOutCSV, []*teb.ScrBp{scr}, true /*no header*/))
+	tassert.Errorf(t, strings.HasPrefix(sb.String(), "ais://b,"), "unexpected %q", sb.String())
+}
+
+func TestScrubAsync(t *testing.T) {
+	job := newScrJob(scrJobPrefix + "abc")
+	tassert.Errorf(t, isScrJobID(job.ID) && !isScrJobID(scrJobPrefix) && !isScrJobID("g4Fmk3Dq"), "job ID")
+
+	args := scrAsyncArgs([]string{"scrub", "s3://abc", "--async", "--limit", "10", "--async=true"}, job, true)
+	exp := []string{"scrub", "s3://abc", "--limit", "10", "--status-file", job.Status, "--yes", "--json"}
+	tassert.Fatalf(t, strings.Join(args, " ") == strings.Join(exp, " "), "expecting %q, got %q", exp, args)
+	args = scrAsyncArgs([]string{"scrub", "s3://abc", "--output=csv", "--async"}, job, false)
+	tassert.Errorf(t, args[len(args)-1] == "--yes", "unexpected %q", args)
+
+	b := &scrStatusBck{Bucket: "ais://b", Names: 1000, Pages: 2, Stats: map[string]teb.CntSiz{
+		strings.ToLower(teb.ScrCols[teb.ScrObjects]):       {Cnt: 1000},
+		strings.ToLower(teb.ScrCols[teb.ScrMisplacedNode]): {Cnt: 3},
+	}}
+	s := b.prnt()
+	tassert.Errorf(t, strings.HasPrefix(s, "ais://b: 1,000 names, 2 page(s), listing") &&
+		strings.HasSuffix(s, "misplaced(cluster): 3"), "unexpected %q", s)
+}
+
+func TestScrubOnlyZero(t *testing.T) {
+	var (
+		ctx = &scrCtx{maxNames: 2}
+		scr = &scrBp{Cname: "ais://b"}
+	)
+	scr.zero(ctx, &cmn.LsoEnt{Name: "a"})
+	tassert.Errorf(t, scr.ZeroSizeCnt == 1 && len(scr.ZeroSize) == 0, "not expecting names without '--only-zero-size'")
+
+	ctx.onlyZero = true
+	for _, name := range []string{"a", "b", "c"} {
+		scr.zero(ctx, &cmn.LsoEnt{Name: name})
+	}
+	tassert.Errorf(t, scr.ZeroSizeCnt == 4 && len(scr.ZeroSize) == 2 && scr.ZeroSize[1] == "b",
+		"expecting 4 counted and 2 names, got %d %q", scr.ZeroSizeCnt, scr.ZeroSize)
+}
+
+func TestScrubFailOn(t *testing.T) {
+	for _, s := range []string{"", "misplaced,", "no-such", "misplaced=1"} {
+		_, err := parseFailOn(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+	fo, err := parseFailOn("misplaced, missing-copies,zero-size")
+	tassert.CheckFatal(t, err)
+
+	a, b := &scrBp{Cname: "ais://a"}, &scrBp{Cname: "ais://b"}
+	a.Stats[teb.ScrNotIn].Cnt = 10
+	tassert.CheckFatal(t, fo.check([]*scrBp{a, b}))
+
+	a.Stats[teb.ScrMisplacedNode].Cnt = 1
+	b.Stats[teb.ScrMisplacedMpath].Cnt = 2
+	b.ZeroSizeCnt = 5
+	err = fo.check([]*scrBp{a, b})
+	var found *errScrFound
+	tassert.Fatalf(t, errors.As(err, &found) && len(found.found) == 2, "expecting 2 found, got %v", err)
+	tassert.Errorf(t, found.found[0] == "misplaced 3 (in 2 buckets)" && found.found[1] == "zero-size 5 (in 1 bucket)",
+		"unexpected %q", found.found)
+
+	var nilfo scrFailOn
+	tassert.Errorf(t, nilfo.check([]*scrBp{a, b}) == nil, "nil: expecting no-op")
+}
+
+// negative: failing to list buckets of any positional URI (not only the first) must fail the scrub
+func TestScrubLsMultiErr(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "no such provider", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	saved := apiBP
+	apiBP = api.BaseParams{URL: srv.URL, Client: srv.Client()}
+	defer func() { apiBP = saved }()
+
+	ctx := &scrCtx{
+		qbck: cmn.QueryBcks{Name: "b", Provider: apc.AIS},
+		more: []cmn.QueryBcks{{Provider: apc.AWS}},
+	}
+	bcks, err := ctx.lsBcks()
+	tassert.Fatalf(t, err != nil, "expecting error, got %d bucket(s)", len(bcks))
+}
@@ -0,0 +1,83 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// '--output=json|csv': one record per bucket, for scripts and spreadsheets
+// - JSON: the canonical scrub result - array of teb.ScrBp, same as '--json' (and the
+//   '--history-bucket' summaries), so that either one can be fed back via '--compare-to'
+// - CSV: header row (unless '--no-headers') followed by one row per bucket; the order of
+//   columns is fixed: scrOutCols, and then (count, size) for each stat in teb.ScrCols order;
+//   stat names are derived from the table columns: lowercase, '_' as separator
+//   (e.g., "MISPLACED(cluster)" => "misplaced_cluster"); all stats, including opt-in ones
+// - stdout carries the records only - progress and notes go to stderr (see infoW)
+
+const (
+	scrOutJSON = "json"
+	scrOutCSV  = "csv"
+)
+
+var scrOutCols = [...]string{"bucket", "prefix", "names", "partial", "elapsed_ms"}
+
+func parseScrOutput(s string) (string, error) {
+	switch s = strings.ToLower(strings.TrimSpace(s)); s {
+	case scrOutJSON, scrOutCSV:
+		return s, nil
+	default:
+		return "", fmt.Errorf("invalid %s=%q (expecting %q or %q)", qflprn(scrubOutputFlag), s, scrOutJSON, scrOutCSV)
+	}
+}
+
+func scrOutKey(i int) string {
+	return strings.NewReplacer("(", "_", ")", "", "-", "_").Replace(strings.ToLower(teb.ScrCols[i]))
+}
+
+func prntScrOutput(w io.Writer, format string, all []*teb.ScrBp, noHeader bool) error {
+	if format == scrOutJSON {
+		b, err := jsoniter.MarshalIndent(all, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if !noHeader {
+		hdr := make([]string, 0, len(scrOutCols)+2*teb.ScrNumStats)
+		hdr = append(hdr, scrOutCols[:]...)
+		for i := range teb.ScrNumStats {
+			key := scrOutKey(i)
+			hdr = append(hdr, key, key+"_size")
+		}
+		if err := cw.Write(hdr); err != nil {
+			return err
+		}
+	}
+	for _, scr := range all {
+		row := make([]string, 0, len(scrOutCols)+2*teb.ScrNumStats)
+		row = append(row, scr.Bck.Cname(""), scr.Prefix, strconv.FormatInt(scr.Names, 10),
+			strconv.FormatBool(scr.Partial), strconv.FormatInt(scr.Elapsed.Milliseconds(), 10))
+		for _, cs := range scr.Stats {
+			row = append(row, strconv.FormatInt(cs.Cnt, 10), strconv.FormatInt(cs.Siz, 10))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
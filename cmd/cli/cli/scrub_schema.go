@@ -0,0 +1,462 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// '--schema': GET in-cluster objects and validate their (JSON) content against a JSON Schema
+// - supported subset: type, enum, const, required, properties, additionalProperties, items,
+//   minItems/maxItems, minLength/maxLength, minimum/maximum, pattern
+// - annotations ($schema, $id, title, description, etc.) are ignored; other keywords
+//   (e.g. $ref, anyOf) are rejected upfront - no silent passes
+// - objects larger than scrSchemaMaxSize are not validated (and counted as such)
+
+const (
+	scrSchemaMaxSize = 64 * cos.MiB
+	scrSchemaSamples = 10 // invalid objects to show, with reasons
+)
+
+type (
+	jschema struct {
+		types    []string
+		enum     []any
+		cnst     any
+		hasConst bool
+		required []string
+		props    map[string]*jschema
+		addl     *jschema // additionalProperties (schema)
+		noAddl   bool     // additionalProperties: false
+		items    *jschema
+		minItems int
+		maxItems int // -1: no limit
+		minLen   int
+		maxLen   int // -1: no limit
+		minimum  *float64
+		maximum  *float64
+		pattern  *regexp.Regexp
+	}
+	scrSchema struct {
+		root    *jschema
+		fname   string
+		sample  int64 // validate every so many in-cluster objects (1: all)
+		seen    atomic.Int64
+		large   atomic.Int64 // not validated (see scrSchemaMaxSize)
+		mu      sync.Mutex
+		samples []string // "bucket/object: reason"
+		nsamp   int
+	}
+)
+
+var jschemaIgnored = map[string]struct{}{
+	"$schema": {}, "$id": {}, "$comment": {}, "title": {}, "description": {},
+	"default": {}, "examples": {}, "format": {}, "$defs": {}, "definitions": {},
+}
+
+func newScrSchema(fname string, sample int64) (*scrSchema, error) {
+	b, err := os.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	root, err := compileSchema(v, "#")
+	if err != nil {
+		return nil, err
+	}
+	return &scrSchema{root: root, fname: fname, sample: max(sample, 1)}, nil
+}
+
+// sampling (see '--sample')
+func (sch *scrSchema) pick() bool {
+	return sch.sample <= 1 || (sch.seen.Inc()-1)%sch.sample == 0
+}
+
+// '--schema': GET and validate (one page at a time)
+func (scr *scrBp) validate(parent *scrCtx, ens []*cmn.LsoEnt) {
+	sch := parent.schema
+	headAll(ens, parent.numWorkers, func(en *cmn.LsoEnt) bool {
+		if en.Size > scrSchemaMaxSize {
+			sch.large.Inc()
+			return true
+		}
+		err := scr.getValidate(sch, en)
+		if err != nil {
+			sch.addSample(scr.Cname+"/"+en.Name, err)
+		}
+		return err == nil
+	}, func(en *cmn.LsoEnt) {
+		scr.Stats[teb.ScrSchemaInvalid].Cnt++
+		scr.Stats[teb.ScrSchemaInvalid].Siz += en.Size
+		scr.log(parent, en, teb.ScrSchemaInvalid)
+	})
+}
+
+// (failure to read the object counts as invalid)
+func (scr *scrBp) getValidate(sch *scrSchema, en *cmn.LsoEnt) error {
+	var (
+		buf  = bytes.NewBuffer(make([]byte, 0, max(en.Size, 512)))
+		args = api.GetArgs{Writer: buf}
+	)
+	if _, err := api.GetObject(apiBP, scr.Bck, en.Name, &args); err != nil {
+		return fmt.Errorf("failed to read: %v", err)
+	}
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+	if dec.More() {
+		return errors.New("invalid JSON: trailing data")
+	}
+	return sch.root.validate(v, "")
+}
+
+func (sch *scrSchema) addSample(name string, err error) {
+	sch.mu.Lock()
+	if len(sch.samples) < scrSchemaSamples {
+		sch.samples = append(sch.samples, name+": "+err.Error())
+	}
+	sch.nsamp++
+	sch.mu.Unlock()
+}
+
+func (ctx *scrCtx) reportSchema() {
+	sch := ctx.schema
+	if sch == nil {
+		return
+	}
+	w := ctx.infoW()
+	if n := sch.large.Load(); n > 0 {
+		fmt.Fprintf(w, "%s: %d object%s larger than %s not validated\n", qflprn(scrubSchemaFlag), n, cos.Plural(int(n)),
+			cos.ToSizeIEC(scrSchemaMaxSize, 0))
+	}
+	if sch.nsamp == 0 {
+		return
+	}
+	fmt.Fprintf(w, "%s: %d object%s failed validation against %q", qflprn(scrubSchemaFlag), sch.nsamp, cos.Plural(sch.nsamp), sch.fname)
+	if sch.nsamp > len(sch.samples) {
+		fmt.Fprintf(w, " (showing the first %d)", len(sch.samples))
+	}
+	fmt.Fprintln(w, ":")
+	for _, s := range sch.samples {
+		fmt.Fprintln(w, indent1+s)
+	}
+}
+
+/////////////
+// jschema //
+/////////////
+
+func compileSchema(v any, where string) (*jschema, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		if b, ok := v.(bool); ok && b {
+			return &jschema{maxItems: -1, maxLen: -1}, nil // "true": anything goes
+		}
+		return nil, fmt.Errorf("%s: expecting schema object, got %s", where, jsonType(v))
+	}
+	var (
+		s    = &jschema{maxItems: -1, maxLen: -1}
+		keys = make([]string, 0, len(m))
+		err  error
+	)
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys) // deterministic errors
+	for _, k := range keys {
+		val := m[k]
+		at := where + "/" + k
+		switch k {
+		case "type":
+			switch t := val.(type) {
+			case string:
+				s.types = []string{t}
+			case []any:
+				for _, ti := range t {
+					ts, ok := ti.(string)
+					if !ok {
+						return nil, fmt.Errorf("%s: expecting string(s)", at)
+					}
+					s.types = append(s.types, ts)
+				}
+			default:
+				return nil, fmt.Errorf("%s: expecting string or array", at)
+			}
+			for _, t := range s.types {
+				switch t {
+				case "null", "boolean", "object", "array", "number", "integer", "string":
+				default:
+					return nil, fmt.Errorf("%s: unknown type %q", at, t)
+				}
+			}
+		case "enum":
+			if s.enum, ok = val.([]any); !ok {
+				return nil, fmt.Errorf("%s: expecting array", at)
+			}
+		case "const":
+			s.cnst, s.hasConst = val, true
+		case "required":
+			arr, ok := val.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: expecting array", at)
+			}
+			for _, r := range arr {
+				rs, ok := r.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: expecting string(s)", at)
+				}
+				s.required = append(s.required, rs)
+			}
+		case "properties":
+			pm, ok := val.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: expecting object", at)
+			}
+			s.props = make(map[string]*jschema, len(pm))
+			for name, pv := range pm {
+				if s.props[name], err = compileSchema(pv, at+"/"+name); err != nil {
+					return nil, err
+				}
+			}
+		case "additionalProperties":
+			if b, ok := val.(bool); ok {
+				s.noAddl = !b
+			} else if s.addl, err = compileSchema(val, at); err != nil {
+				return nil, err
+			}
+		case "items":
+			if s.items, err = compileSchema(val, at); err != nil {
+				return nil, err
+			}
+		case "minItems", "maxItems", "minLength", "maxLength":
+			n, ok := val.(float64)
+			if !ok || n < 0 || n != math.Trunc(n) {
+				return nil, fmt.Errorf("%s: expecting non-negative integer", at)
+			}
+			switch k {
+			case "minItems":
+				s.minItems = int(n)
+			case "maxItems":
+				s.maxItems = int(n)
+			case "minLength":
+				s.minLen = int(n)
+			default:
+				s.maxLen = int(n)
+			}
+		case "minimum", "maximum":
+			n, ok := val.(float64)
+			if !ok {
+				return nil, fmt.Errorf("%s: expecting number", at)
+			}
+			if k == "minimum" {
+				s.minimum = &n
+			} else {
+				s.maximum = &n
+			}
+		case "pattern":
+			ps, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: expecting string", at)
+			}
+			if s.pattern, err = regexp.Compile(ps); err != nil {
+				return nil, fmt.Errorf("%s: %v", at, err)
+			}
+		default:
+			if _, ok := jschemaIgnored[k]; !ok {
+				return nil, fmt.Errorf("%s: unsupported keyword %q", where, k)
+			}
+		}
+	}
+	return s, nil
+}
+
+// `ptr` is JSON pointer to the value (empty: root)
+func (s *jschema) validate(v any, ptr string) error {
+	if len(s.types) > 0 && !s.typeOK(v) {
+		return fmt.Errorf("%s: expecting %s, got %s", jptr(ptr), strings.Join(s.types, " or "), jsonType(v))
+	}
+	if s.hasConst && !jsonEq(v, s.cnst) {
+		return fmt.Errorf("%s: not equal to const", jptr(ptr))
+	}
+	if s.enum != nil {
+		var found bool
+		for _, e := range s.enum {
+			if found = jsonEq(v, e); found {
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: not one of enum values", jptr(ptr))
+		}
+	}
+	switch x := v.(type) {
+	case map[string]any:
+		for _, r := range s.required {
+			if _, ok := x[r]; !ok {
+				return fmt.Errorf("%s: missing required property %q", jptr(ptr), r)
+			}
+		}
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sub, ok := s.props[k]
+			switch {
+			case ok:
+			case s.noAddl:
+				return fmt.Errorf("%s: unexpected property %q", jptr(ptr), k)
+			case s.addl != nil:
+				sub = s.addl
+			default:
+				continue
+			}
+			if err := sub.validate(x[k], ptr+"/"+k); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if len(x) < s.minItems {
+			return fmt.Errorf("%s: expecting at least %d items, got %d", jptr(ptr), s.minItems, len(x))
+		}
+		if s.maxItems >= 0 && len(x) > s.maxItems {
+			return fmt.Errorf("%s: expecting at most %d items, got %d", jptr(ptr), s.maxItems, len(x))
+		}
+		if s.items != nil {
+			for i, item := range x {
+				if err := s.items.validate(item, ptr+"/"+strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		l := utf8.RuneCountInString(x)
+		if l < s.minLen {
+			return fmt.Errorf("%s: expecting length >= %d, got %d", jptr(ptr), s.minLen, l)
+		}
+		if s.maxLen >= 0 && l > s.maxLen {
+			return fmt.Errorf("%s: expecting length <= %d, got %d", jptr(ptr), s.maxLen, l)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(x) {
+			return fmt.Errorf("%s: does not match pattern %q", jptr(ptr), s.pattern.String())
+		}
+	case json.Number:
+		f, err := x.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: %v", jptr(ptr), err)
+		}
+		if s.minimum != nil && f < *s.minimum {
+			return fmt.Errorf("%s: %s is less than minimum %v", jptr(ptr), x, *s.minimum)
+		}
+		if s.maximum != nil && f > *s.maximum {
+			return fmt.Errorf("%s: %s is greater than maximum %v", jptr(ptr), x, *s.maximum)
+		}
+	}
+	return nil
+}
+
+func (s *jschema) typeOK(v any) bool {
+	vt := jsonType(v)
+	for _, t := range s.types {
+		if t == vt || (t == "number" && vt == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jptr(ptr string) string {
+	if ptr == "" {
+		return "/"
+	}
+	return ptr
+}
+
+// JSON Schema type name (values decoded with UseNumber; schemas without)
+func jsonType(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if f, err := x.Float64(); err == nil && f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return "integer"
+		}
+		return "number"
+	case float64:
+		if x == math.Trunc(x) && !math.IsInf(x, 0) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// compare decoded value with the one from the schema (enum, const)
+func jsonEq(v, s any) bool {
+	switch x := v.(type) {
+	case json.Number:
+		f, err := x.Float64()
+		sf, ok := s.(float64)
+		return err == nil && ok && f == sf
+	case map[string]any:
+		sm, ok := s.(map[string]any)
+		if !ok || len(sm) != len(x) {
+			return false
+		}
+		for k, xv := range x {
+			sv, ok := sm[k]
+			if !ok || !jsonEq(xv, sv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		sa, ok := s.([]any)
+		if !ok || len(sa) != len(x) {
+			return false
+		}
+		for i := range x {
+			if !jsonEq(x[i], sa[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return v == s
+	}
+}
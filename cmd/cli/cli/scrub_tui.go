@@ -0,0 +1,468 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+
+	"golang.org/x/term"
+)
+
+// '--tui': full-screen live view
+// - one row per bucket: names scrubbed so far, progress bar, and non-zero counters
+//   (the bar is relative to the largest bucket scrubbed so far or, if specified, to '--limit')
+// - updated by the listing goroutines upon every page (see ctx.progress);
+//   redrawn every tuiRefresh and upon every key
+// - keys: see tuiHelp; 'q' (or Ctrl-C) stops scrubbing, same as SIGINT
+// - once done, stays on screen until 'q' - then prints the regular (final) results
+// - falls back to regular output when stdin or stdout is not a terminal
+
+const (
+	tuiRefresh    = time.Second
+	tuiMaxFlagged = 1000 // per bucket, most recent
+	tuiBarWidth   = 20
+
+	tuiHelp      = "q: quit, s: sort, c: show/hide clean, ↑/↓: select, enter: flagged objects"
+	tuiHelpDrill = "q: quit, ↑/↓: scroll, enter (or b): back"
+)
+
+// sort order
+const (
+	tuiByName = iota
+	tuiByNames
+	tuiByIssues
+	tuiNumSorts
+)
+
+var tuiSortNames = [tuiNumSorts]string{"bucket", "names", "issues"}
+
+// ANSI
+const (
+	tuiAltScreen  = "\x1b[?1049h\x1b[?25l" // (and hide cursor)
+	tuiMainScreen = "\x1b[?25h\x1b[?1049l"
+	tuiClear      = "\x1b[H\x1b[2J"
+	tuiReverse    = "\x1b[7m"
+	tuiReset      = "\x1b[0m"
+)
+
+type (
+	tuiFlagged struct {
+		name  string
+		issue string
+		size  int64
+	}
+	tuiBck struct {
+		cname   string
+		err     string // skipped (see gols)
+		flagged []tuiFlagged
+		nflag   int64
+		names   int64
+		stats   [teb.ScrNumStats]teb.CntSiz
+		done    bool
+	}
+	scrTUI struct {
+		ctx     *scrCtx
+		out     *os.File
+		state   *term.State
+		bcks    map[string]*tuiBck
+		order   []*tuiBck // as added
+		drill   *tuiBck   // showing flagged objects
+		keys    chan byte
+		quit    chan struct{}
+		started int64
+		limit   int64 // '--limit', if any
+		sel     int
+		top     int // first visible row
+		sortBy  int
+		once    sync.Once
+		mu      sync.Mutex
+		hide    bool // clean buckets
+		fin     bool
+	}
+)
+
+func newScrTUI(ctx *scrCtx) *scrTUI {
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		actionNote(ctx.c, "not a terminal - ignoring "+qflprn(scrubTUIFlag))
+		return nil
+	}
+	t := &scrTUI{
+		ctx:     ctx,
+		out:     os.Stdout,
+		bcks:    make(map[string]*tuiBck, 8),
+		keys:    make(chan byte, 16),
+		quit:    make(chan struct{}),
+		started: mono.NanoTime(),
+		sortBy:  tuiByIssues,
+	}
+	if flagIsSet(ctx.c, objLimitFlag) {
+		t.limit = int64(parseIntFlag(ctx.c, objLimitFlag))
+	}
+	return t
+}
+
+func (t *scrTUI) start() error {
+	if t == nil {
+		return nil
+	}
+	state, err := term.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return fmt.Errorf("%s: %v", qflprn(scrubTUIFlag), err)
+	}
+	t.state = state
+	fmt.Fprint(t.out, tuiAltScreen)
+	go t.readKeys()
+	go t.run()
+	return nil
+}
+
+// restore terminal (idempotent)
+func (t *scrTUI) close() {
+	if t == nil {
+		return
+	}
+	t.once.Do(func() {
+		close(t.quit)
+		t.mu.Lock()
+		fmt.Fprint(t.out, tuiMainScreen)
+		term.Restore(int(os.Stdin.Fd()), t.state)
+		t.mu.Unlock()
+	})
+}
+
+// all done: keep showing until 'q'
+func (t *scrTUI) wait() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.fin = true
+	t.mu.Unlock()
+	t.redraw()
+	if !t.ctx.stopped.Load() {
+		<-t.quit
+	}
+}
+
+func (t *scrTUI) readKeys() {
+	var b [8]byte
+	for {
+		n, err := os.Stdin.Read(b[:])
+		if err != nil {
+			return
+		}
+		// arrows: ESC '[' 'A' (up) and ESC '[' 'B' (down)
+		if n == 3 && b[0] == 0x1b && b[1] == '[' {
+			switch b[2] {
+			case 'A':
+				b[0] = 'k'
+			case 'B':
+				b[0] = 'j'
+			}
+		}
+		select {
+		case t.keys <- b[0]:
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+func (t *scrTUI) run() {
+	ticker := time.NewTicker(tuiRefresh)
+	defer ticker.Stop()
+	t.redraw()
+	for {
+		select {
+		case <-ticker.C:
+		case key := <-t.keys:
+			if t.key(key) {
+				t.ctx.stopped.Store(true)
+				t.close()
+				return
+			}
+		case <-t.quit:
+			return
+		}
+		t.redraw()
+	}
+}
+
+// returns true to quit
+func (t *scrTUI) key(key byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch key {
+	case 'q', 3 /*Ctrl-C*/ :
+		return true
+	case 'j':
+		t.sel++
+	case 'k':
+		t.sel = max(t.sel-1, 0)
+	case 's':
+		if t.drill == nil {
+			t.sortBy = (t.sortBy + 1) % tuiNumSorts
+		}
+	case 'c':
+		if t.drill == nil {
+			t.hide = !t.hide
+			t.sel, t.top = 0, 0
+		}
+	case '\r', '\n':
+		if t.drill != nil {
+			t.drill, t.sel, t.top = nil, 0, 0
+		} else if rows := t.rows(); t.sel < len(rows) {
+			t.drill, t.sel, t.top = rows[t.sel], 0, 0
+		}
+	case 'b', 0x7f /*backspace*/ :
+		t.drill, t.sel, t.top = nil, 0, 0
+	}
+	return false
+}
+
+func (t *scrTUI) redraw() {
+	width, height, err := term.GetSize(int(t.out.Fd()))
+	if err != nil {
+		width, height = 120, 40
+	}
+	t.mu.Lock()
+	lines := t.lines(width, height)
+	select {
+	case <-t.quit:
+	default:
+		fmt.Fprint(t.out, tuiClear+strings.Join(lines, "\r\n"))
+	}
+	t.mu.Unlock()
+}
+
+//
+// updates (nil-safe)
+//
+
+func (t *scrTUI) get(cname string) *tuiBck {
+	b, ok := t.bcks[cname]
+	if !ok {
+		b = &tuiBck{cname: cname}
+		t.bcks[cname] = b
+		t.order = append(t.order, b)
+	}
+	return b
+}
+
+// (called by the listing goroutine that owns `scr`)
+func (t *scrTUI) upd(scr *scrBp) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	b := t.get(scr.Cname)
+	b.names, b.stats = scr.Names, scr.Stats
+	t.mu.Unlock()
+}
+
+func (t *scrTUI) done(scr *scrBp) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	b := t.get(scr.Cname)
+	b.names, b.stats, b.done = scr.Names, scr.Stats, true
+	t.mu.Unlock()
+}
+
+func (t *scrTUI) skip(cname, reason string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	b := t.get(cname)
+	b.err, b.done = reason, true
+	t.mu.Unlock()
+}
+
+func (t *scrTUI) flag(scr *scrBp, en *cmn.LsoEnt, issue string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	b := t.get(scr.Cname)
+	f := tuiFlagged{name: en.Name, issue: issue, size: en.Size}
+	if len(b.flagged) < tuiMaxFlagged {
+		b.flagged = append(b.flagged, f)
+	} else {
+		b.flagged[b.nflag%tuiMaxFlagged] = f
+	}
+	b.nflag++
+	t.mu.Unlock()
+}
+
+//
+// rendering (under lock)
+//
+
+func (b *tuiBck) issues() (n int64) {
+	for i := 1; i < len(b.stats); i++ { // skipping listed objects (same as ctx.progress)
+		n += b.stats[i].Cnt
+	}
+	return n
+}
+
+// visible buckets, sorted
+func (t *scrTUI) rows() []*tuiBck {
+	rows := make([]*tuiBck, 0, len(t.order))
+	for _, b := range t.order {
+		if t.hide && b.done && b.err == "" && b.issues() == 0 {
+			continue
+		}
+		rows = append(rows, b)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		switch t.sortBy {
+		case tuiByNames:
+			return rows[i].names > rows[j].names
+		case tuiByIssues:
+			return rows[i].issues() > rows[j].issues()
+		default:
+			return rows[i].cname < rows[j].cname
+		}
+	})
+	return rows
+}
+
+func (t *scrTUI) lines(width, height int) []string {
+	var (
+		lines []string
+		body  []string
+		hdr   string
+		state = "scrubbing"
+	)
+	if t.fin {
+		state = "done"
+	} else if t.ctx.stopped.Load() {
+		state = "stopping"
+	}
+	elapsed := teb.FmtDuration(int64(mono.Since(t.started).Round(time.Second)), t.ctx.units)
+	if t.drill != nil {
+		b := t.drill
+		lines = append(lines,
+			fmt.Sprintf("%s: flagged objects (most recent %d of %d) [%s, %s]", b.cname, len(b.flagged), b.nflag, state, elapsed),
+			tuiHelpDrill, "")
+		hdr = fmt.Sprintf("  %-20s %12s  %s", "ISSUE", "SIZE", "NAME")
+		flagged := b.recent()
+		for _, f := range flagged {
+			body = append(body, fmt.Sprintf("  %-20s %12s  %s", f.issue, teb.FmtSize(f.size, t.ctx.units, 2), f.name))
+		}
+	} else {
+		var (
+			rows   = t.rows()
+			nmax   = t.limit
+			hidden string
+		)
+		for _, b := range t.order {
+			nmax = max(nmax, b.names)
+		}
+		if t.hide {
+			hidden = ", clean hidden"
+		}
+		lines = append(lines,
+			fmt.Sprintf("Scrub: %d bucket(s), %s names [%s, %s; sort by %s%s]", len(t.order),
+				cos.FormatBigI64(t.ctx.total.Load()), state, elapsed, tuiSortNames[t.sortBy], hidden),
+			tuiHelp, "")
+		hdr = fmt.Sprintf("  %-32s %14s  %-*s  %s", "BUCKET", "NAMES", tuiBarWidth+2, "PROGRESS", "ISSUES")
+		t.sel = min(t.sel, max(len(rows)-1, 0))
+		for _, b := range rows {
+			body = append(body, fmt.Sprintf("  %-32s %14s  %s  %s", b.cname, cos.FormatBigI64(b.names), b.bar(nmax), b.counters()))
+		}
+	}
+
+	// scroll (title, help, and header stay in place)
+	lines = append(lines, hdr)
+	for i := range lines {
+		lines[i] = tuiTrunc(lines[i], width)
+	}
+	visible := max(height-len(lines), 1)
+	if t.drill != nil {
+		t.sel = min(t.sel, max(len(body)-visible, 0))
+		t.top = t.sel
+	} else {
+		if t.sel < t.top {
+			t.top = t.sel
+		} else if t.sel >= t.top+visible {
+			t.top = t.sel - visible + 1
+		}
+	}
+	for i := t.top; i < len(body) && i < t.top+visible; i++ {
+		ln := tuiTrunc(body[i], width)
+		if t.drill == nil && i == t.sel {
+			ln = tuiReverse + ">" + ln[1:] + tuiReset
+		}
+		lines = append(lines, ln)
+	}
+	return lines
+}
+
+// oldest to newest
+func (b *tuiBck) recent() []tuiFlagged {
+	if b.nflag <= tuiMaxFlagged {
+		return b.flagged
+	}
+	i := int(b.nflag % tuiMaxFlagged)
+	return append(append(make([]tuiFlagged, 0, tuiMaxFlagged), b.flagged[i:]...), b.flagged[:i]...)
+}
+
+func (b *tuiBck) bar(nmax int64) string {
+	n := tuiBarWidth
+	if nmax > 0 {
+		n = int(min(b.names*tuiBarWidth/nmax, tuiBarWidth))
+	}
+	bar := "[" + strings.Repeat("#", n) + strings.Repeat(".", tuiBarWidth-n) + "]"
+	switch {
+	case b.err != "":
+		return bar + " skipped: " + b.err
+	case b.done:
+		return bar + " done"
+	default:
+		return bar + "     "
+	}
+}
+
+func (b *tuiBck) counters() string {
+	var sb strings.Builder
+	for i := 1; i < len(b.stats); i++ {
+		if cnt := b.stats[i].Cnt; cnt != 0 {
+			if sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(strings.ToLower(teb.ScrCols[i]))
+			sb.WriteByte(':')
+			sb.WriteString(strconv.FormatInt(cnt, 10))
+		}
+	}
+	if sb.Len() == 0 {
+		return "-"
+	}
+	return sb.String()
+}
+
+func tuiTrunc(s string, width int) string {
+	if width <= 0 || len(s) <= width {
+		return s
+	}
+	if r := []rune(s); len(r) > width {
+		return string(r[:width])
+	}
+	return s
+}
@@ -57,7 +57,8 @@ const scrubUsage = "Validate in-cluster content for inconsistencies and data pla
 	indent1 + "\t- 'ais scrub'\t- same as above;\n" +
 	indent1 + "\t- 'ais scrub ais://bucket'\t- validate a specific AIS bucket;\n" +
 	indent1 + "\t- 'ais scrub gs://abc/images/'\t- validate part of the GCP bucket under \"images/\";\n" +
-	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix."
+	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix;\n" +
+	indent1 + "\t- 'ais scrub s3:// ais://'\t- all S3 and all AIS buckets in one go (totals by provider)."
 
 // {verb}-mountpath usage:
 const (
@@ -274,7 +275,7 @@ var (
 	scrubCmd = cli.Command{
 		Name:         cmdScrub,
 		Usage:        scrubUsage,
-		ArgsUsage:    lsAnyCommandArgument,
+		ArgsUsage:    scrubArgument,
 		Flags:        sortFlags(scrubFlags),
 		Action:       scrubHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
@@ -0,0 +1,59 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"text/template"
+)
+
+// named (custom) templates, to select via '--template' option
+
+var (
+	registry = make(map[string]string, 4)
+	regMu    sync.RWMutex
+)
+
+// Register adds (or replaces) a named template; note that parsing does not
+// check referenced fields - see Validate
+func Register(name, tmpl string) error {
+	if name == "" {
+		return errors.New("template name cannot be empty")
+	}
+	if _, err := template.New(name).Funcs(funcMap).Parse(tmpl); err != nil {
+		return fmt.Errorf("invalid template %q: %w", name, err)
+	}
+	regMu.Lock()
+	registry[name] = tmpl
+	regMu.Unlock()
+	return nil
+}
+
+func Lookup(name string) (tmpl string, ok bool) {
+	regMu.RLock()
+	tmpl, ok = registry[name]
+	regMu.RUnlock()
+	return tmpl, ok
+}
+
+// Validate executes the template against a sample value (of the type that will be printed)
+// to make sure that all referenced fields and methods exist
+func Validate(name string, sample any) error {
+	tmpl, ok := Lookup(name)
+	if !ok {
+		return fmt.Errorf("template %q not found", name)
+	}
+	parsed, err := template.New(name).Funcs(funcMap).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("invalid template %q: %w", name, err)
+	}
+	if err := parsed.Execute(io.Discard, sample); err != nil {
+		return fmt.Errorf("invalid template %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRegisterTemplate(t *testing.T) {
+	sample := []*teb.ScrBp{{}}
+
+	// builtin
+	err := teb.Validate(teb.ScrBriefName, sample)
+	tassert.CheckFatal(t, err)
+
+	// valid
+	err = teb.Register("test-valid", "{{range $s := .}}{{$s.Prefix}}\t{{(index $s.Stats 0).Cnt}}\n{{end}}")
+	tassert.CheckFatal(t, err)
+	err = teb.Validate("test-valid", sample)
+	tassert.CheckFatal(t, err)
+
+	// syntax error
+	err = teb.Register("test-syntax", "{{range .}}")
+	tassert.Fatalf(t, err != nil, "expected syntax error")
+
+	// nonexistent field: parses ok but fails validation with a clear error
+	err = teb.Register("test-field", "{{range $s := .}}{{$s.NoSuchField}}{{end}}")
+	tassert.CheckFatal(t, err)
+	err = teb.Validate("test-field", sample)
+	tassert.Fatalf(t, err != nil, "expected validation error")
+	tassert.Errorf(t, strings.Contains(err.Error(), "NoSuchField") && strings.Contains(err.Error(), "test-field"),
+		"expected error to name the template and the field, got: %v", err)
+
+	// not registered
+	err = teb.Validate("test-nonexistent", sample)
+	tassert.Fatalf(t, err != nil, "expected not-found error")
+}
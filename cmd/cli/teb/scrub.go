@@ -5,7 +5,10 @@
 package teb
 
 import (
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -25,6 +28,16 @@ const (
 	colLargeSz        = "LARGE"
 	colVchanged       = "VER-CHANGED"
 	colVremoved       = "DELETED"
+	colBadName        = "BAD-NAME"        // violates naming policy (regex)
+	colMetaMismatch   = "META-MISMATCH"   // stored metadata vs listed (size, checksum, version)
+	colExcluded       = "EXCLUDED"        // skipped via exclude-prefix(es)
+	colPendingDel     = "PENDING-DEL"     // listed but not live: remote deleted, or leftover copy w/ main replica missing
+	colDupContent     = "DUP-CONTENT"     // same checksum and size as (a previously listed) different name
+	colSchemaInvalid  = "SCHEMA-INVALID"  // content fails validation against JSON Schema
+	colNoCksum        = "NO-CKSUM"        // in-cluster object without checksum (e.g., written with checksumming disabled)
+	colCopyDiverged   = "COPY-DIVERGENCE" // mirrored object: replicas disagree (checksum or size)
+	colEmptyDir       = "EMPTY-DIR"       // virtual directory (e.g., "dir/" marker) with no objects underneath
+	colElapsed        = "ELAPSED(rate)"   // wall time and names/s (not a stat)
 )
 
 const (
@@ -37,33 +50,93 @@ const (
 	ScrLargeSz
 	ScrVchanged
 	ScrVremoved
+	ScrBadName
+	ScrMetaMismatch
+	ScrExcluded
+	ScrPendingDel
+	ScrDupContent
+	ScrSchemaInvalid
+	ScrNoCksum
+	ScrCopyDiverged
+	ScrEmptyDir
 
 	ScrNumStats // NOTE: must be the last
 )
 
 var (
-	ScrCols = [...]string{colObjects, colNotIn, colMisplacedNode, colMisplacedMpath, colMissingCp, colSmallSz, colLargeSz, colVchanged, colVremoved}
+	ScrCols = [...]string{colObjects, colNotIn, colMisplacedNode, colMisplacedMpath, colMissingCp, colSmallSz, colLargeSz, colVchanged, colVremoved, colBadName, colMetaMismatch, colExcluded, colPendingDel, colDupContent, colSchemaInvalid, colNoCksum, colCopyDiverged, colEmptyDir}
 	ScrNums = [ScrNumStats]int64{}
+
+	// opt-in metrics: hidden unless explicitly enabled (see MakeTab)
+	scrOptIn = [...]int{ScrBadName, ScrMetaMismatch, ScrExcluded, ScrPendingDel, ScrDupContent, ScrSchemaInvalid, ScrNoCksum, ScrCopyDiverged, ScrEmptyDir}
+)
+
+// builtin custom template (see Register and '--template')
+const (
+	ScrBriefName = "scrub-brief"
+	scrBriefTmpl = "{{range $s := .}}{{$s.Bck.Cname $s.Prefix}}\t{{(index $s.Stats 0).Cnt}} objects" +
+		"{{if $s.Partial}} (partial){{end}}\n{{end}}"
 )
 
+func init() {
+	err := Register(ScrBriefName, scrBriefTmpl)
+	debug.AssertNoErr(err)
+}
+
 type (
 	CntSiz struct {
-		Cnt int64
-		Siz int64
+		Cnt int64 `json:"cnt"`
+		Siz int64 `json:"size"`
 	}
 	ScrBp struct {
-		Bck    cmn.Bck
-		Prefix string
-		Stats  [ScrNumStats]CntSiz
+		Bck    cmn.Bck             `json:"bucket"`
+		Prefix string              `json:"prefix,omitempty"`
+		Stats  [ScrNumStats]CntSiz `json:"stats"` // indexed by Scr* constants (append-only)
+		// interrupted (e.g., via Ctrl-C) prior to visiting all objects
+		Partial bool `json:"partial,omitempty"`
+		// all listed names (including virtual dirs) and wall time
+		Names   int64         `json:"names"`
+		Elapsed time.Duration `json:"elapsed"`
+		// zero-size objects: total count and, with '--only-zero-size', names (up to '--max-names')
+		ZeroSize    []string `json:"zero_size,omitempty"`
+		ZeroSizeCnt int64    `json:"zero_size_cnt,omitempty"`
 		// work
-		Line  cos.SB
-		Cname string
+		Line  cos.SB `json:"-"`
+		Cname string `json:"-"`
 	}
 	ScrubHelper struct {
 		All []*ScrBp
+		// roll-up rows grouped by (provider, namespace) - see MakeTab
+		Subtotals bool
 	}
+	// findings grouped by [tnode:mountpath]
+	ScrLoc struct {
+		Location  string
+		Misplaced CntSiz
+		MissingCp CntSiz
+		Note      string // e.g., "(mountpath disabled)"
+	}
+	ScrLocs []*ScrLoc
 )
 
+// informational counters - not issues (see HasIssues)
+var scrInfo = [...]int{ScrObjects, ScrNotIn, ScrLargeSz, ScrExcluded}
+
+func (scr *ScrBp) HasIssues() bool {
+outer:
+	for i := range scr.Stats {
+		for _, j := range scrInfo {
+			if i == j {
+				continue outer
+			}
+		}
+		if scr.Stats[i].Cnt > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *ScrubHelper) colFirst() string {
 	var num int
 	for _, scr := range h.All {
@@ -81,14 +154,15 @@ func (h *ScrubHelper) colFirst() string {
 	}
 }
 
-func (h *ScrubHelper) MakeTab(units string, haveRemote, allColumns bool) *Table {
+func (h *ScrubHelper) MakeTab(units string, haveRemote, allColumns bool, enabled ...int) *Table {
 	debug.Assert(len(ScrCols) == len(ScrNums))
 
-	cols := make([]*header, 1, len(ScrCols)+1)
+	cols := make([]*header, 1, len(ScrCols)+2)
 	cols[0] = &header{name: h.colFirst()}
 	for _, col := range ScrCols {
 		cols = append(cols, &header{name: col})
 	}
+	cols = append(cols, &header{name: colElapsed})
 
 	table := newTable(cols...)
 
@@ -103,19 +177,83 @@ func (h *ScrubHelper) MakeTab(units string, haveRemote, allColumns bool) *Table
 		h._hideCol(cols, colVchanged)
 		h._hideCol(cols, colVremoved)
 	}
+	for _, i := range scrOptIn {
+		if !slices.Contains(enabled, i) {
+			h._hideCol(cols, ScrCols[i])
+		}
+	}
 
 	// make tab
+	if !h.Subtotals {
+		for _, scr := range h.All {
+			table.addRow(scr.row(units, scr.Bck.Cname(scr.Prefix)))
+		}
+		return table
+	}
+	for _, group := range h.groups() {
+		for _, scr := range group {
+			table.addRow(scr.row(units, scr.Bck.Cname(scr.Prefix)))
+		}
+		table.addRow(sepaRow(cols))
+		table.addRow(scrSubtotal(group).row(units, "SUBTOTAL "+scrGroup(&group[0].Bck)))
+	}
+	return table
+}
+
+func (scr *ScrBp) row(units, name string) row {
+	row := make([]string, 1, len(ScrCols)+2)
+	row[0] = name
+	if scr.Partial {
+		row[0] += " (partial)"
+	}
+	for _, v := range scr.Stats {
+		row = append(row, fmtCntSiz(v, units))
+	}
+	return append(row, FmtElapsedRate(scr.Names, scr.Elapsed))
+}
+
+// (provider, namespace) key, e.g. "s3://" or "ais://@uuid#ns"
+func scrGroup(bck *cmn.Bck) string {
+	return cmn.QueryBcks{Provider: bck.Provider, Ns: bck.Ns}.String()
+}
+
+// groups in order of the first occurrence (preserving the order within each group)
+func (h *ScrubHelper) groups() (groups [][]*ScrBp) {
+	idx := make(map[string]int, 4)
 	for _, scr := range h.All {
-		row := make([]string, 1, len(ScrCols)+1)
-		row[0] = scr.Bck.Cname(scr.Prefix)
+		key := scrGroup(&scr.Bck)
+		i, ok := idx[key]
+		if !ok {
+			i = len(groups)
+			idx[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], scr)
+	}
+	return groups
+}
 
-		for _, v := range scr.Stats {
-			row = append(row, scr.fmtVal(v, units))
+// counters and names: summed up; elapsed: the longest (buckets are scrubbed concurrently)
+func scrSubtotal(group []*ScrBp) *ScrBp {
+	sub := &ScrBp{}
+	for _, scr := range group {
+		for i := range scr.Stats {
+			sub.Stats[i].Cnt += scr.Stats[i].Cnt
+			sub.Stats[i].Siz += scr.Stats[i].Siz
 		}
-		table.addRow(row)
+		sub.Names += scr.Names
+		sub.Elapsed = max(sub.Elapsed, scr.Elapsed)
+		sub.Partial = sub.Partial || scr.Partial
 	}
+	return sub
+}
 
-	return table
+func sepaRow(cols []*header) row {
+	row := make([]string, len(cols))
+	for i, col := range cols {
+		row[i] = strings.Repeat("-", len(col.name))
+	}
+	return row
 }
 
 // missing-cp: hide when all-zeros
@@ -139,9 +277,37 @@ func (*ScrubHelper) _hideCol(cols []*header, name string) {
 // format values
 const zeroCnt = "-"
 
-func (*ScrBp) fmtVal(v CntSiz, units string) string {
+func fmtCntSiz(v CntSiz, units string) string {
 	if v.Cnt == 0 {
 		return zeroCnt
 	}
 	return strconv.FormatInt(v.Cnt, 10) + " (" + FmtSize(v.Siz, units, 1) + ")"
 }
+
+// e.g. "1m5s (12345/s)"
+func FmtElapsedRate(n int64, elapsed time.Duration) string {
+	if elapsed <= 0 {
+		return zeroCnt
+	}
+	rate := float64(n) / max(elapsed.Seconds(), 1e-3)
+	return FormatDuration(elapsed) + " (" + strconv.FormatInt(int64(rate), 10) + "/s)"
+}
+
+/////////////
+// ScrLocs //
+/////////////
+
+func (locs ScrLocs) MakeTab(units string) *Table {
+	note := &header{name: "NOTE", hide: true}
+	for _, loc := range locs {
+		if loc.Note != "" {
+			note.hide = false
+			break
+		}
+	}
+	table := newTable(&header{name: "LOCATION"}, &header{name: "MISPLACED"}, &header{name: colMissingCp}, note)
+	for _, loc := range locs {
+		table.addRow(row{loc.Location, fmtCntSiz(loc.Misplaced, units), fmtCntSiz(loc.MissingCp, units), loc.Note})
+	}
+	return table
+}
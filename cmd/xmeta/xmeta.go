@@ -42,6 +42,7 @@ var flags struct {
 	disable string
 	// behavior
 	extract bool
+	inspect bool
 	help    bool
 	quiet   bool
 }
@@ -89,6 +90,8 @@ Examples:
 	# EMD (ETL Metadata):
 	xmeta -x -in=~/.ais0/.ais.emd                     - extract EMD to STDOUT
 	xmeta -x -in=~/.ais0/.ais.emd -out=/tmp/emd.txt   - extract EMD to /tmp/emd.txt
+	# Inspect (any AIS-formatted metadata):
+	xmeta -i -in=~/.ais0/.ais.bmd                     - name the content, e.g.: "bucket-metadata (BMD) v5 (jsp v3, cksum, lz4)"
 `
 )
 
@@ -130,6 +133,7 @@ func main() {
 	newFlag := flag.NewFlagSet(os.Args[0], flag.ExitOnError) // discard flags of imported packages
 	newFlag.BoolVar(&flags.extract, "x", false,
 		"true: extract AIS-formatted metadata type, false: pack and AIS-format plain-text metadata")
+	newFlag.BoolVar(&flags.inspect, "i", false, "inspect AIS-formatted metadata: show content kind, version, and encoding (use with -in)")
 	newFlag.StringVar(&flags.in, "in", "", "fully-qualified input filename")
 	newFlag.StringVar(&flags.out, "out", "", "output filename (optional when extracting)")
 	newFlag.BoolVar(&flags.help, "h", false, "print usage and exit")
@@ -152,6 +156,14 @@ func main() {
 		flags.out = cos.ExpandPath(flags.out)
 	}
 
+	if flags.inspect {
+		if err := inspect(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to inspect %s: %v\n", flags.in, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// VMD edit operations
 	if flags.enable != "" || flags.disable != "" {
 		if err := editVMD(); err != nil {
@@ -172,6 +184,20 @@ func main() {
 	}
 }
 
+func inspect() error {
+	fh, err := os.Open(flags.in)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	h, err := jsp.Sniff(fh, flags.in)
+	if err != nil {
+		return err
+	}
+	fmt.Println(h.String())
+	return nil
+}
+
 func editVMD() error {
 	if flags.in == "" {
 		return errors.New("input file required (-in)")
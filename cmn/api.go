@@ -65,7 +65,7 @@ type (
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
-		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
+		Features    feat.Flags      `json:"features"`                         // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
 		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
 		Created     int64           `json:"created,string" list:"readonly"`   // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                       // see "inherit"
@@ -212,7 +212,7 @@ type (
 		RateLimit *RateLimitConfToSet `json:"rate_limit,omitempty"` // +gen:optional
 		// Bitwise feature flags scoped to this bucket. See `feat.Flags`
 		// for the flag definitions.
-		Features *feat.Flags `json:"features,string,omitempty"` // +gen:optional
+		Features *feat.Flags `json:"features,omitempty"` // +gen:optional
 		// When to persist metadata and data writes.
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"` // +gen:optional
 		// Provider-specific extras (S3, GCS, Azure, OCI, HTTP).
@@ -132,7 +132,7 @@ type (
 		Periodic    PeriodConf      `json:"periodic" allow:"cluster"`
 		Client      ClientConf      `json:"client"`
 		Downloader  DownloaderConf  `json:"downloader"`
-		Features    feat.Flags      `json:"features,string" allow:"cluster"` // to flip assorted global defaults (see cmn/feat/feat and docs/feat*)
+		Features    feat.Flags      `json:"features" allow:"cluster"` // to flip assorted global defaults (see cmn/feat/feat and docs/feat*)
 		Version     int64           `json:"config_version,string"`
 		Versioning  VersionConf     `json:"versioning" allow:"cluster"`
 		Resilver    ResilverConf    `json:"resilver"`
@@ -170,7 +170,7 @@ type (
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
-		Features    *feat.Flags           `json:"features,string,omitempty"`
+		Features    *feat.Flags           `json:"features,omitempty"`
 		GetBatch    *GetBatchConfToSet    `json:"get_batch,omitempty"`
 
 		// LocalConfig
@@ -1131,6 +1131,7 @@ var (
 func _jspOpts() jsp.Options {
 	opts := jsp.CCSign(MetaverConfig)
 	opts.OldMetaverOk = 3
+	opts.Kind = jsp.KindConfig
 	return opts
 }
 
@@ -3038,6 +3039,10 @@ func LoadConfig(globalConfPath, localConfPath, daeRole string, config *Config) e
 		return err
 	}
 
+	if u := config.Features.Unknown(); u != 0 {
+		nlog.Warningf("global config: unknown feature flags %#x (set by a newer version?) - preserved but ignored", uint64(u))
+	}
+
 	// initialize atomic part of the config including most often used timeouts and features
 	Rom.Set(&config.ClusterConfig)
 	Rom.testingEnv = config.TestingEnv()
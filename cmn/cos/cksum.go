@@ -51,9 +51,10 @@ type (
 
 	// in-cluster checksum validation (compare with cmn.ErrInvalidCksum)
 	ErrBadCksum struct {
-		prefix  string
 		a, b    any
+		prefix  string
 		context string
+		Source  string // originating file (or other identifier), when known
 	}
 	Cksum struct {
 		ty    string `json:"-"` // Without "json" tag, IterFields function panics
@@ -420,6 +421,9 @@ func (e *ErrBadCksum) Error() string {
 	if e.context != "" {
 		context = " (context: " + e.context + ")"
 	}
+	if e.Source != "" && !strings.Contains(e.context, e.Source) {
+		context += " (source: " + e.Source + ")"
+	}
 	cka, ok1 := e.a.(*Cksum)
 	ckb, ok2 := e.b.(*Cksum)
 	if ok1 && ok2 {
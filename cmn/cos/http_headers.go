@@ -45,11 +45,16 @@ const (
 	HdrContentLength      = "Content-Length"
 
 	// misc. gen
-	HdrUserAgent = "User-Agent"
-	HdrAccept    = "Accept"
-	HdrLocation  = "Location"
-	HdrServer    = "Server"
-	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrUserAgent  = "User-Agent"
+	HdrAccept     = "Accept"
+	HdrLocation   = "Location"
+	HdrServer     = "Server"
+	HdrETag       = "ETag"        // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrRetryAfter = "Retry-After" // with 429 and 503; seconds or HTTP-date
+
+	// conditional requests
+	HdrIfNoneMatch     = "If-None-Match"
+	HdrIfModifiedSince = "If-Modified-Since"
 
 	HdrHSTS = "Strict-Transport-Security"
 
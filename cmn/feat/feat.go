@@ -5,9 +5,13 @@
 package feat
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -52,6 +56,10 @@ const (
 	CountObjectNotFoundStats  // count GET(object) 404 (not-found) as errors (default: don't); TODO: add Prometheus to count HEAD(object) errors
 	EnableGoRuntimeMetrics    // publish selected Go runtime metrics via Prometheus
 	DloadAllowPrivateEgress   // allow downloader egress to private RFC1918/ULA addresses; loopback and link-local remain blocked
+	VerboseMetaErrors         // when failing to decode persisted metadata, include tag, byte offset, and detected version(s)
+	FsyncMeta                 // when persisting metadata via jsp.EncodeFileAtomic: fsync the file prior to rename, and the directory after
+	S3MptReverseProxy         // S3 multipart: reverse-proxy part uploads to designated targets instead of HTTP-redirecting (compare with S3ReverseProxy)
+	VerifyStreamingColdGET    // with StreamingColdGET: compute source checksum inline and validate it at the end of the stream (when the backend provides one)
 )
 
 var Cluster = [...]string{
@@ -82,6 +90,10 @@ var Cluster = [...]string{
 	"Count-Object-NotFound-Stats",
 	"Enable-Go-Runtime-Metrics",
 	"Dload-Allow-Private-Egress",
+	"Verbose-Meta-Errors",
+	"Fsync-Meta",
+	"S3-MPU-Reverse-Proxy",
+	"Verify-Streaming-Cold-GET",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -96,6 +108,8 @@ var Bucket = [...]string{
 	"S3-ListObjectVersions",
 	"Resume-Interrupted-MPU",
 	"Count-Object-NotFound-Stats",
+	"S3-MPU-Reverse-Proxy",
+	"Verify-Streaming-Cold-GET",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -110,10 +124,69 @@ func (f *Flags) Validate() error {
 
 func (f *Flags) ValidateAsProps(...any) error { return f.Validate() }
 
+// JSON: always written as quoted decimal bitmask, e.g. "1234" - the format every version reads
+// (formerly, via `json:",string"` in cmn.Config and cmn.Bprops)
+func (f Flags) MarshalJSON() ([]byte, error) { return []byte(strconv.Quote(f.String())), nil }
+
+// UnmarshalJSON accepts both representations (rolling-upgrade compatibility):
+// - decimal bitmask: 1234 or "1234"
+// - names: ["S3-Use-Path-Style", "Fsync-PUT"] or "S3-Use-Path-Style,Fsync-PUT" (see Names)
+// Unknown bits (set by a newer version) are preserved; unknown names are rejected.
+func (f *Flags) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case len(b) == 0:
+		return errors.New("feature flags: empty input")
+	case string(b) == "null":
+		return nil
+	case b[0] == '[':
+		var names []string
+		if err := cos.JSON.Unmarshal(b, &names); err != nil {
+			return fmt.Errorf("feature flags: %v", err)
+		}
+		return f.fromNames(names)
+	case b[0] == '"':
+		s, err := strconv.Unquote(string(b))
+		if err != nil {
+			return fmt.Errorf("feature flags: %v", err)
+		}
+		b = []byte(s)
+		if s == "" || (s[0] < '0' || s[0] > '9') {
+			return f.fromNames(strings.Split(s, ","))
+		}
+	}
+	n, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		return fmt.Errorf("feature flags: invalid bitmask %q: %v", b, err)
+	}
+	*f = Flags(n)
+	return nil
+}
+
+func (f *Flags) fromNames(names []string) error {
+	var flags Flags
+	for _, n := range names {
+		nf, err := CSV2Feat(strings.TrimSpace(n))
+		if err != nil {
+			return err
+		}
+		flags = flags.Set(nf)
+	}
+	*f = flags
+	return nil
+}
+
 func (f Flags) IsSet(flag Flags) bool { return cos.BitFlags(f).IsSet(cos.BitFlags(flag)) }
 func (f Flags) Set(flags Flags) Flags { return Flags(cos.BitFlags(f).Set(cos.BitFlags(flags))) }
 func (f Flags) String() string        { return strconv.FormatUint(uint64(f), 10) }
 
+// Unknown returns bits this binary does not know about, e.g. set by a newer version
+// (Names ignores them; String preserves them numerically)
+func (f Flags) Unknown() Flags {
+	const known = Flags(1)<<len(Cluster) - 1
+	return f &^ known
+}
+
 func IsBucketScope(name string) bool {
 	for i := range Bucket {
 		if name == Bucket[i] {
@@ -156,6 +229,57 @@ func (f Flags) Names() (names []string) {
 	return names
 }
 
+// for rendering: set features grouped by scope, each group sorted
+// (union of the two equals Names())
+func (f Flags) Pretty() (bucketScoped, clusterOnly []string) {
+	for _, name := range f.Names() {
+		if IsBucketScope(name) {
+			bucketScoped = append(bucketScoped, name)
+		} else {
+			clusterOnly = append(clusterOnly, name)
+		}
+	}
+	sort.Strings(bucketScoped)
+	sort.Strings(clusterOnly)
+	return bucketScoped, clusterOnly
+}
+
+// ApplyToBucket adds and removes named features to/from the current bucket's features:
+// - names must be known (see Cluster)
+// - added names must be bucket-scoped (see Bucket)
+// - the result must validate (see Validate)
+// Returns the resulting flags or the first error; `current` is never modified.
+func ApplyToBucket(current Flags, add, remove []string) (Flags, error) {
+	f := current
+	for _, n := range remove {
+		rf, err := CSV2Feat(n)
+		if err != nil {
+			return current, err
+		}
+		f &^= rf
+	}
+	for _, n := range add {
+		af, err := CSV2Feat(n)
+		if err != nil {
+			return current, err
+		}
+		if af == 0 {
+			continue // empty or reset token
+		}
+		if !IsBucketScope(n) {
+			return current, fmt.Errorf("feature flag %q is cluster-scope only (cannot be set on a bucket)", n)
+		}
+		if slices.Contains(remove, n) {
+			return current, fmt.Errorf("feature flag %q cannot be added and removed at the same time", n)
+		}
+		f = f.Set(af)
+	}
+	if err := f.Validate(); err != nil {
+		return current, err
+	}
+	return f, nil
+}
+
 func (f Flags) ClearName(n string) Flags {
 	for i, name := range Cluster {
 		if name == n {
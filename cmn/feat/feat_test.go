@@ -0,0 +1,182 @@
+// Package feat: global runtime-configurable feature flags
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package feat_test
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPretty(t *testing.T) {
+	tests := []feat.Flags{
+		0,
+		feat.VerboseMetaErrors,
+		feat.EnforceIntraClusterAccess | feat.SkipVC | feat.DisableColdGET,
+		^feat.Flags(0) >> (64 - len(feat.Cluster)), // all
+	}
+	for _, f := range tests {
+		bucketScoped, clusterOnly := f.Pretty()
+		tassert.Errorf(t, sort.StringsAreSorted(bucketScoped), "%v: bucket-scoped not sorted", bucketScoped)
+		tassert.Errorf(t, sort.StringsAreSorted(clusterOnly), "%v: cluster-only not sorted", clusterOnly)
+		for _, name := range bucketScoped {
+			tassert.Errorf(t, feat.IsBucketScope(name), "%q is not bucket-scoped", name)
+		}
+		for _, name := range clusterOnly {
+			tassert.Errorf(t, !feat.IsBucketScope(name), "%q is bucket-scoped", name)
+		}
+
+		union := make([]string, 0, len(bucketScoped)+len(clusterOnly))
+		union = append(union, bucketScoped...)
+		union = append(union, clusterOnly...)
+		names := f.Names()
+		sort.Strings(union)
+		sort.Strings(names)
+		tassert.Fatalf(t, len(union) == len(names), "%v vs %v", union, names)
+		for i := range names {
+			tassert.Errorf(t, union[i] == names[i], "%v vs %v", union, names)
+		}
+	}
+}
+
+func TestApplyToBucket(t *testing.T) {
+	var (
+		fsync  = feat.FsyncPUT.Names()[0]
+		nocold = feat.DisableColdGET.Names()[0]
+		stream = feat.StreamingColdGET.Names()[0]
+		intra  = feat.EnforceIntraClusterAccess.Names()[0] // cluster-only
+	)
+	// ok
+	f, err := feat.ApplyToBucket(feat.SkipVC, []string{fsync, nocold}, []string{feat.SkipVC.Names()[0]})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, f == feat.FsyncPUT|feat.DisableColdGET, "unexpected result %v", f.Names())
+
+	// remove-then-add resolves a would-be conflict
+	f, err = feat.ApplyToBucket(feat.DisableColdGET, []string{stream}, []string{nocold})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, f == feat.StreamingColdGET, "unexpected result %v", f.Names())
+
+	tests := []struct {
+		name        string
+		current     feat.Flags
+		add, remove []string
+	}{
+		{"unknown-add", 0, []string{"No-Such-Feature"}, nil},
+		{"unknown-remove", 0, nil, []string{"No-Such-Feature"}},
+		{"cluster-only", 0, []string{intra}, nil},
+		{"conflict", feat.DisableColdGET, []string{stream}, nil},
+		{"add-and-remove", 0, []string{fsync}, []string{fsync}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := feat.ApplyToBucket(test.current, test.add, test.remove)
+			tassert.Fatalf(t, err != nil, "expecting error, got %v", f.Names())
+			tassert.Errorf(t, f == test.current, "current flags must remain intact on error")
+		})
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	known := feat.FsyncPUT | feat.DisableColdGET
+	tassert.Errorf(t, known.Unknown() == 0, "expecting no unknown bits, got %#x", uint64(known.Unknown()))
+
+	const high = feat.Flags(1) << 63
+	f := known | high
+	tassert.Errorf(t, f.Unknown() == high, "expecting %#x, got %#x", uint64(high), uint64(f.Unknown()))
+	tassert.Errorf(t, len(f.Names()) == 2, "unknown bits must not have names: %v", f.Names())
+
+	// round-trip preserves unknown bits
+	n, err := strconv.ParseUint(f.String(), 10, 64)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, feat.Flags(n) == f, "round-trip: %#x vs %#x", n, uint64(f))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type conf struct {
+		Features feat.Flags  `json:"features"`
+		Opt      *feat.Flags `json:"opt,omitempty"`
+	}
+	var (
+		exp   = feat.FsyncPUT | feat.S3UsePathStyle
+		names = exp.Names()
+		num   = exp.String()
+	)
+	for _, in := range []string{
+		`{"features":` + num + `}`,
+		`{"features":"` + num + `"}`,
+		`{"features":["` + names[0] + `","` + names[1] + `"]}`,
+		`{"features":"` + names[0] + `,` + names[1] + `"}`,
+	} {
+		var c conf
+		tassert.CheckFatal(t, cos.JSON.Unmarshal([]byte(in), &c))
+		tassert.Errorf(t, c.Features == exp, "%s: expected %v, got %v", in, names, c.Features.Names())
+		tassert.Errorf(t, c.Opt == nil, "%s: expected nil", in)
+	}
+
+	// written as before (quoted decimal) - older versions can read it
+	b, err := cos.JSON.Marshal(conf{Features: exp, Opt: &exp})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == `{"features":"`+num+`","opt":"`+num+`"}`, "unexpected %s", b)
+
+	// unknown bits survive, unknown names don't
+	var c conf
+	tassert.CheckFatal(t, cos.JSON.Unmarshal([]byte(`{"features":"9223372036854775808"}`), &c))
+	tassert.Errorf(t, c.Features.Unknown() == feat.Flags(1)<<63, "expected unknown bit, got %#x", uint64(c.Features))
+	err = cos.JSON.Unmarshal([]byte(`{"features":["No-Such-Feature"]}`), &c)
+	tassert.Errorf(t, err != nil, "expected error")
+}
+
+// bit positions are persisted (cluster config, bucket props) - must never change
+func TestS3MptReverseProxy(t *testing.T) {
+	const name = "S3-MPU-Reverse-Proxy"
+	f := feat.S3MptReverseProxy
+	tassert.Errorf(t, f == feat.Flags(1)<<29, "bit moved: %#x", uint64(f))
+	tassert.Errorf(t, feat.FsyncMeta == feat.Flags(1)<<28, "bit moved: %#x", uint64(feat.FsyncMeta))
+	tassert.Errorf(t, feat.Cluster[29] == name, "expecting %q at position 29, got %q", name, feat.Cluster[29])
+	tassert.Errorf(t, feat.IsBucketScope(name), "%q must be bucket-scoped", name)
+	tassert.Errorf(t, f.Unknown() == 0, "must be known")
+
+	// names
+	names := f.Names()
+	tassert.Fatalf(t, len(names) == 1 && names[0] == name, "unexpected names %v", names)
+	parsed, err := feat.CSV2Feat(name)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, parsed == f, "round-trip: %#x vs %#x", uint64(parsed), uint64(f))
+
+	// bucket
+	bf, err := feat.ApplyToBucket(feat.FsyncPUT, []string{name}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bf == feat.FsyncPUT|f, "unexpected result %v", bf.Names())
+
+	// JSON
+	b, err := cos.JSON.Marshal(bf)
+	tassert.CheckFatal(t, err)
+	var out feat.Flags
+	tassert.CheckFatal(t, cos.JSON.Unmarshal(b, &out))
+	tassert.Errorf(t, out == bf, "round-trip: %s vs %v", b, bf.Names())
+}
+
+func TestVerifyStreamingColdGET(t *testing.T) {
+	const name = "Verify-Streaming-Cold-GET"
+	f := feat.VerifyStreamingColdGET
+	tassert.Errorf(t, f == feat.Flags(1)<<30, "bit moved: %#x", uint64(f))
+	tassert.Errorf(t, feat.StreamingColdGET == feat.Flags(1)<<12, "bit moved: %#x", uint64(feat.StreamingColdGET))
+	tassert.Errorf(t, feat.Cluster[30] == name, "expecting %q at position 30, got %q", name, feat.Cluster[30])
+	tassert.Errorf(t, feat.IsBucketScope(name), "%q must be bucket-scoped", name)
+	tassert.Errorf(t, f.Unknown() == 0, "must be known")
+
+	parsed, err := feat.CSV2Feat(name)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, parsed == f, "round-trip: %#x vs %#x", uint64(parsed), uint64(f))
+
+	// companion
+	bf, err := feat.ApplyToBucket(0, []string{feat.StreamingColdGET.Names()[0], name}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bf == feat.StreamingColdGET|f, "unexpected result %v", bf.Names())
+}
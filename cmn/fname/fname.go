@@ -45,6 +45,9 @@ const (
 	// Token
 	Token = "auth.token"
 
+	// downloader: Merkle manifest (basename, suffixed with job ID)
+	DloadManifest = ".ais.dload_manifest"
+
 	// Markers: per mountpath
 
 	// TODO add the two distinct "skipped" markers:
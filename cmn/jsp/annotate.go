@@ -0,0 +1,66 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"bufio"
+	"io"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Options.Annotate: a single line ahead of the (indented) JSON, for humans browsing on-disk files:
+//   {"_meta":{"note":"aisnode v4.1 config","time":"2026-10-16T19:03:01Z"}}
+// - written only when Indent is on and compression and checksumming are off
+// - Decode strips it only when the signature prefix says so (flagAnnotate) or,
+//   for unsigned files, when the caller's options specify Annotate; otherwise, plain JSON
+//   that happens to start with `{"_meta":` is decoded as is
+
+const metaPrefix = `{"_meta":`
+
+type metaLine struct {
+	Note string `json:"note"`
+	Time string `json:"time,omitempty"` // (none when Deterministic)
+}
+
+func (opts *Options) annotated() bool {
+	return opts.Annotate != "" && opts.Indent && !opts.Compress && !opts.Checksum && !opts.BlockCksum &&
+		opts.Format == FmtJSON
+}
+
+func writeMeta(w io.Writer, opts *Options) error {
+	ml := metaLine{Note: opts.Annotate}
+	if !opts.Deterministic {
+		ml.Time = time.Now().UTC().Format(time.RFC3339)
+	}
+	b, err := cos.JSON.Marshal(ml)
+	if err != nil {
+		return err
+	}
+	line := make([]byte, 0, len(metaPrefix)+len(b)+2)
+	line = append(line, metaPrefix...)
+	line = append(line, b...)
+	line = append(line, '}', '\n')
+	_, err = w.Write(line)
+	return err
+}
+
+// skip the annotation line, if present
+func stripMeta(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, len(metaPrefix))
+	b, err := br.Peek(len(metaPrefix))
+	if err != nil || string(b) != metaPrefix {
+		return br, nil // (let the decoder deal with short reads)
+	}
+	for {
+		_, err = br.ReadSlice('\n')
+		if err != bufio.ErrBufferFull {
+			break
+		}
+	}
+	return br, err
+}
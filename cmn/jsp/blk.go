@@ -0,0 +1,167 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Block checksum (see Options.BlockCksum)
+//
+// Following the prefix, the layout is:
+//   [ total payload length (u64) ][ block-1 | rolling cksum (u64) ] ... [ block-N | rolling cksum (u64) ]
+//
+// where each block (except possibly the last) carries `blkSize` bytes of (compressed, if
+// enabled) payload, and the rolling xxhash is computed over the entire payload so far.
+// Decoding verifies each block _before_ handing it over to decompression and parsing,
+// and fails with cos.ErrBadCksum as soon as a block does not match.
+
+const blkSize = 64 * cos.KiB
+
+type (
+	blkWriter struct {
+		w     io.Writer
+		h     *onexxh.XXHash64
+		buf   []byte
+		total int64
+	}
+	blkReader struct {
+		r      io.Reader
+		h      *onexxh.XXHash64
+		buf    []byte
+		tag    string
+		err    error
+		off    int
+		remain int64
+	}
+)
+
+///////////////
+// blkWriter //
+///////////////
+
+func newBlkWriter(w io.Writer) *blkWriter {
+	return &blkWriter{w: w, h: onexxh.New64(), buf: make([]byte, 0, blkSize)}
+}
+
+func (bw *blkWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		k := min(len(p), blkSize-len(bw.buf))
+		bw.buf = append(bw.buf, p[:k]...)
+		p = p[k:]
+		n += k
+		if len(bw.buf) == blkSize {
+			if err = bw.flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (bw *blkWriter) flush() error {
+	if len(bw.buf) == 0 {
+		return nil
+	}
+	var cksum [cos.SizeXXHash64]byte
+	bw.h.Write(bw.buf)
+	binary.BigEndian.PutUint64(cksum[:], bw.h.Sum64())
+	if _, err := bw.w.Write(bw.buf); err != nil {
+		return err
+	}
+	if _, err := bw.w.Write(cksum[:]); err != nil {
+		return err
+	}
+	bw.total += int64(len(bw.buf))
+	bw.buf = bw.buf[:0]
+	return nil
+}
+
+///////////////
+// blkReader //
+///////////////
+
+func (br *blkReader) Read(p []byte) (int, error) {
+	if br.off == len(br.buf) {
+		if br.err = br.next(); br.err != nil {
+			return 0, br.err
+		}
+	}
+	n := copy(p, br.buf[br.off:])
+	br.off += n
+	return n, nil
+}
+
+func (br *blkReader) next() error {
+	if br.remain == 0 {
+		return io.EOF
+	}
+	l := int(min(br.remain, blkSize))
+	if cap(br.buf) < l+cos.SizeXXHash64 {
+		br.buf = make([]byte, 0, l+cos.SizeXXHash64)
+	}
+	br.buf = br.buf[:l+cos.SizeXXHash64]
+	if _, err := io.ReadFull(br.r, br.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	br.h.Write(br.buf[:l])
+	expected, actual := binary.BigEndian.Uint64(br.buf[l:]), br.h.Sum64()
+	if expected != actual {
+		return cos.NewErrMetaCksum(expected, actual, br.tag)
+	}
+	br.buf, br.off = br.buf[:l], 0
+	br.remain -= int64(l)
+	return nil
+}
+
+func withBlkCksum(r io.Reader, v any, opts Options, tag string, lr *limReader, dr *depthReader) (*cos.Cksum, error) {
+	var total [cos.SizeofI64]byte
+	if _, err := io.ReadFull(r, total[:]); err != nil {
+		return nil, err
+	}
+	br := &blkReader{r: r, h: onexxh.New64(), tag: tag, remain: int64(binary.BigEndian.Uint64(total[:]))}
+
+	var rr io.Reader = br
+	if opts.Compress {
+		var err error
+		if rr, err = newZR(br, lr); err != nil {
+			return nil, err
+		}
+	}
+	rr = lr.wrap(rr)
+	var err error
+	if opts.Format == FmtMsgPack {
+		err = decodeMsgp(rr, v)
+	} else {
+		if err = cos.JSON.NewDecoder(dr.wrap(rr)).Decode(v); err == nil {
+			err = drainEOL(rr)
+		}
+	}
+	// block checksum error takes precedence (the parser's is a mere consequence)
+	if br.err != nil && br.err != io.EOF {
+		return nil, br.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	// verify the remaining blocks, if any
+	if _, err := io.Copy(io.Discard, br); err != nil {
+		return nil, err
+	}
+
+	var cksum [cos.SizeXXHash64]byte
+	binary.BigEndian.PutUint64(cksum[:], br.h.Sum64())
+	return cos.NewCksum(cos.ChecksumOneXxh, hex.EncodeToString(cksum[:])), nil
+}
@@ -0,0 +1,91 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"io"
+)
+
+// Options.MaxJSONDepth: bound the nesting of JSON objects and arrays _before_ the parser
+// gets to recurse into them (e.g., hostile "[[[[...") by scanning the bytes on their way in:
+// - zero: DefaultMaxJSONDepth; negative: no limit
+// - JSON only (msgpack payloads are not scanned)
+// - exceeding the limit fails Decode (and DecodeFields) with ErrMaxDepth
+
+const DefaultMaxJSONDepth = 1000
+
+type depthReader struct {
+	r     io.Reader
+	err   error
+	limit int
+	depth int
+	str   bool // inside a string
+	esc   bool // (ditto) escaped
+}
+
+// nil when there's no limit
+func newDepthReader(opts *Options) *depthReader {
+	switch {
+	case opts.MaxJSONDepth < 0 || opts.Format != FmtJSON:
+		return nil
+	case opts.MaxJSONDepth == 0:
+		return &depthReader{limit: DefaultMaxJSONDepth}
+	default:
+		return &depthReader{limit: opts.MaxJSONDepth}
+	}
+}
+
+// nil-safe (no limit)
+func (dr *depthReader) wrap(r io.Reader) io.Reader {
+	if dr == nil {
+		return r
+	}
+	dr.r = r
+	return dr
+}
+
+func (dr *depthReader) Read(p []byte) (int, error) {
+	if dr.err != nil {
+		return 0, dr.err
+	}
+	n, err := dr.r.Read(p)
+	for i, c := range p[:n] {
+		if dr.str {
+			switch {
+			case dr.esc:
+				dr.esc = false
+			case c == '\\':
+				dr.esc = true
+			case c == '"':
+				dr.str = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			dr.str = true
+		case '[', '{':
+			dr.depth++
+			if dr.depth > dr.limit {
+				dr.err = &ErrMaxDepth{dr.limit}
+				return i, dr.err
+			}
+		case ']', '}':
+			if dr.depth > 0 {
+				dr.depth--
+			}
+		}
+	}
+	return n, err
+}
+
+// (the limit takes precedence over the parser's error, if any)
+func (dr *depthReader) check(err error) error {
+	if dr != nil && dr.err != nil {
+		return dr.err
+	}
+	return err
+}
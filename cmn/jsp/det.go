@@ -0,0 +1,36 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Options.Deterministic: the same value always yields the same bytes (and checksum) -
+// to content-address, dedupe, and cache-key metadata blobs
+// - own frozen config (sorted map keys, shortest round-trip floats, no HTML escaping),
+//   independent of cos.JSON settings
+// - Annotate: note only, no timestamp
+// - JSON only: msgp-generated encoders range over Go maps (random order)
+// - lz4 and xxhash are deterministic as is (same input, same block size)
+
+var detJSON = jsoniter.Config{
+	EscapeHTML:  false,
+	SortMapKeys: true,
+}.Froze()
+
+var errDetMsgPack = errors.New("jsp: deterministic encoding requires JSON format (msgpack map order is random)")
+
+func (opts *Options) jsonAPI() jsoniter.API {
+	if opts.Deterministic {
+		return detJSON
+	}
+	return cos.JSON
+}
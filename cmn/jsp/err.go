@@ -27,12 +27,43 @@ type (
 	ErrUnsupportedMetaVersion struct {
 		ErrVersion
 	}
+	// decoded (decompressed) size exceeds Options.MaxDecodedSize
+	ErrSizeLimit struct {
+		limit int64
+	}
+	// JSON nesting exceeds Options.MaxJSONDepth (see depth.go)
+	ErrMaxDepth struct {
+		limit int
+	}
+	// with feat.VerboseMetaErrors (see SetVerbose)
+	ErrDecode struct {
+		err     error
+		tag     string
+		off     int64 // bytes consumed from the source (approx., given read-ahead buffering)
+		metaVer uint32
+		jspVer  byte
+	}
 )
 
 func (e *ErrBadSignature) Error() string {
 	return fmt.Sprintf("bad signature %q: got %s, expected %s", e.tag, e.got, e.expected)
 }
 
+func (e *ErrSizeLimit) Error() string {
+	return fmt.Sprintf("jsp: decoded size exceeds the limit (%d bytes)", e.limit)
+}
+
+func (e *ErrMaxDepth) Error() string {
+	return fmt.Sprintf("jsp: JSON nesting depth exceeds the limit (%d)", e.limit)
+}
+
+func (e *ErrDecode) Error() string {
+	return fmt.Sprintf("failed to decode %q [offset %d, jsp version %d, meta-version %d]: %v",
+		e.tag, e.off, e.jspVer, e.metaVer, e.err)
+}
+
+func (e *ErrDecode) Unwrap() error { return e.err }
+
 func newErrVersion(tag string, got, expected uint32, compatibles ...uint32) error {
 	err := &ErrVersion{tag, got, expected}
 	if slices.Contains(compatibles, got) {
@@ -22,11 +22,100 @@ const (
 	prefLen = 2 * cos.SizeofI64 // [ signature | jsp ver | meta version |   bit flags  ]
 )
 
-// current JSP version
+// prefix bit flags [ 96 - 127 ]
 const (
-	Metaver = 3
+	flagCompress = 1 << iota
+	flagChecksum
+	flagMsgPack
+	flagBlkCksum
+	flagParity   // (see parity.go)
+	flagAnnotate // leading annotation line (see annotate.go)
+	// bits 24-31: Options.Kind (see kind.go)
 )
 
+const (
+	flagsV3   = flagCompress | flagChecksum
+	flagsExt  = flagMsgPack | flagBlkCksum | flagParity | flagAnnotate
+	flagsMask = 1<<kindShift - 1 // low-order flags (all but Kind)
+)
+
+// JSP versions
+//   - v3 is still written when only compression and/or checksum is used
+//     (so that older readers keep loading the vast majority of metadata)
+//   - v4 otherwise, so that older readers reject what they cannot decode
+const (
+	Metaver    = 3 // compression and/or checksum
+	MetaverExt = 4 // any of the extended flags (see flagsExt)
+)
+
+// on-disk layout, for external tools (see also: Layout)
+const (
+	PrefixLen      = prefLen          // signature prefix (when Options.Signature)
+	ChecksumOffset = prefLen          // xxhash64 of the (uncompressed) payload follows the prefix, big-endian
+	ChecksumLen    = cos.SizeXXHash64 // (when Options.Checksum)
+	BlkTotalLen    = cos.SizeofI64    // total payload length, big-endian (when Options.BlockCksum, instead of the checksum)
+)
+
+// Layout describes where things are, given the options (offsets in bytes; zero length: not present):
+//
+//	[ prefix (PrefixLen) ][ checksum (ChecksumLen) | block-mode total (BlkTotalLen) ][ payload ... ]
+type Layout struct {
+	PrefixLen   int // signature, jsp version, meta version, flags
+	ChecksumOff int
+	ChecksumLen int
+	BlkTotalOff int
+	BlkTotalLen int
+	ParityOff   int // (when Options.Parity) parity header; parity shards and shard hashes follow the payload
+	ParityLen   int
+	PayloadOff  int // lz4-compressed when Options.Compress; 64KiB checksummed blocks when Options.BlockCksum
+}
+
+func LayoutOf(opts Options) (l Layout) {
+	if opts.Signature {
+		l.PrefixLen = PrefixLen
+	}
+	l.PayloadOff = l.PrefixLen
+	if opts.Parity {
+		l.ParityOff, l.ParityLen = l.PayloadOff, parityHdrLen
+		l.PayloadOff += parityHdrLen
+	}
+	switch {
+	case opts.BlockCksum:
+		l.BlkTotalOff, l.BlkTotalLen = l.PayloadOff, BlkTotalLen
+		l.PayloadOff += BlkTotalLen
+	case opts.Checksum:
+		l.ChecksumOff, l.ChecksumLen = l.PayloadOff, ChecksumLen
+		l.PayloadOff += ChecksumLen
+	}
+	return l
+}
+
+// read-only accessors for external tools (that must not copy the constants above)
+func Signature() string { return signature }
+func MetaVersion() byte { return Metaver }
+
+// Is returns true if the bytes start with a valid jsp prefix: signature followed by a supported jsp version
+func Is(b []byte) bool {
+	l := len(signature)
+	return len(b) > l && string(b[:l]) == signature && (b[l] == Metaver || b[l] == MetaverExt)
+}
+
+// jsp version to write, given the flags
+func jspVersion(flags uint32) byte {
+	if flags&flagsExt != 0 {
+		return MetaverExt
+	}
+	return Metaver
+}
+
+// low-order flags known to a given jsp version
+func knownFlags(jspVer byte) uint32 {
+	if jspVer == Metaver {
+		return flagsV3
+	}
+	return flagsV3 | flagsExt
+}
+
 //////////////////
 // main methods //
 //////////////////
@@ -93,7 +182,9 @@ func Load(filepath string, v any, opts Options) (checksum *cos.Cksum, err error)
 	if err == nil {
 		return
 	}
-	if errors.Is(err, &cos.ErrBadCksum{}) {
+	var errC *cos.ErrBadCksum
+	if errors.As(err, &errC) {
+		errC.Source = filepath
 		if errRm := os.Remove(filepath); errRm == nil {
 			cos.Errorf("jsp: %v, removed %q", err, filepath)
 		} else {
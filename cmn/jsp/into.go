@@ -0,0 +1,38 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Decoding arrays into pre-allocated slices, e.g. many similar payloads in a loop:
+// - the JSON decoder appends into the existing backing array (growing it only when needed),
+//   but it also decodes _into_ existing elements - stale fields would survive
+//   (e.g., omitempty fields absent in the current payload)
+// - DecodeInto and NextInto therefore zero the entire capacity first, and then reuse it
+// - value-type elements ([]T) benefit the most; with []*T the pointers get reset as well
+// - upon error, `dst` content is undefined (but its capacity is retained)
+// - see also cos.ResetSliceCap to bound the capacity retained across iterations
+
+func DecodeInto[T any](r io.Reader, dst *[]T, opts Options, tag string) (*cos.Cksum, error) {
+	_reuse(dst)
+	return Decode(r, dst, opts, tag)
+}
+
+// StreamDecoder.Next counterpart (generic methods are not a thing)
+func NextInto[T any](d *StreamDecoder, dst *[]T) error {
+	_reuse(dst)
+	return d.Next(dst)
+}
+
+func _reuse[T any](dst *[]T) {
+	s := (*dst)[:cap(*dst)]
+	clear(s)
+	*dst = s[:0]
+}
@@ -6,32 +6,117 @@
 package jsp
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"hash"
 	"io"
+	"reflect"
 
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 
 	onexxh "github.com/OneOfOne/xxhash"
 	"github.com/pierrec/lz4/v4"
+	"github.com/tinylib/msgp/msgp"
 )
 
 const (
 	lz4BufferSize = lz4.Block64Kb
 )
 
+// feat.VerboseMetaErrors (set via cmn.Rom)
+var verbose atomic.Bool
+
+func SetVerbose(v bool) { verbose.Store(v) }
+
+type (
+	// detected at decoding time, for verbose errors
+	dinfo struct {
+		metaVer uint32
+		jspVer  byte
+	}
+	cntReader struct {
+		r io.Reader
+		n int64
+	}
+)
+
+// Options.MaxDecodedSize
+type limReader struct {
+	r      io.Reader
+	err    error
+	limit  int64
+	remain int64
+}
+
+// nil-safe (no limit)
+func (lr *limReader) wrap(r io.Reader) io.Reader {
+	if lr == nil {
+		return r
+	}
+	lr.r = r
+	return lr
+}
+
+func (lr *limReader) Read(p []byte) (int, error) {
+	if lr.err != nil {
+		return 0, lr.err
+	}
+	if int64(len(p)) > lr.remain+1 {
+		p = p[:lr.remain+1] // one more to detect overflow
+	}
+	n, err := lr.r.Read(p)
+	if int64(n) > lr.remain {
+		n = int(lr.remain)
+		lr.remain = 0
+		lr.err = &ErrSizeLimit{lr.limit}
+		return n, lr.err
+	}
+	lr.remain -= int64(n)
+	return n, err
+}
+
+func (cr *cntReader) Read(p []byte) (n int, err error) {
+	n, err = cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
 func Encode(ws cos.WriterAt, v any, opts Options) error {
 	var (
 		zw  *lz4.Writer
+		bw  *blkWriter
 		h   hash.Hash
 		w   io.Writer = ws
 		off int
 	)
+	if opts.Deterministic && opts.Format != FmtJSON {
+		return errDetMsgPack
+	}
+	if opts.Parity {
+		if !opts.Signature {
+			return errParitySign
+		}
+		return encodeParity(ws, v, opts)
+	}
+	if opts.plain() {
+		if opts.annotated() {
+			if err := writeMeta(ws, &opts); err != nil {
+				return err
+			}
+		}
+		return encodeJSON(ws, v, &opts) // fast path
+	}
+	if opts.BlockCksum {
+		debug.Assert(opts.Signature, "block checksum requires signature")
+		opts.Checksum = false
+	}
+	debug.Assert(opts.Kind == KindNone || opts.Signature, "kind requires signature")
 	//
 	// 1. header
 	//
@@ -43,18 +128,28 @@ func Encode(ws cos.WriterAt, v any, opts Options) error {
 		copy(prefix[:], signature) // [ 0 - 63 ]
 		l := len(signature)
 		debug.Assert(l < cos.SizeofI64)
-		prefix[l] = Metaver // current jsp version
 		off += cos.SizeofI64
 
 		binary.BigEndian.PutUint32(prefix[off:], opts.Metaver) // [ 64 - 95 ]
 		off += cos.SizeofI32
 
 		if opts.Compress { // [ 96 - 127 ]
-			flags |= 1 << 0
+			flags |= flagCompress
 		}
 		if opts.Checksum {
-			flags |= 1 << 1
+			flags |= flagChecksum
+		}
+		if opts.Format == FmtMsgPack {
+			flags |= flagMsgPack
 		}
+		if opts.BlockCksum {
+			flags |= flagBlkCksum
+		}
+		if opts.annotated() {
+			flags |= flagAnnotate
+		}
+		prefix[l] = jspVersion(flags)
+		flags |= uint32(opts.Kind) << kindShift
 		binary.BigEndian.PutUint32(prefix[off:], flags)
 		off += cos.SizeofI32
 
@@ -69,10 +164,16 @@ func Encode(ws cos.WriterAt, v any, opts Options) error {
 			return err
 		}
 	}
+	if opts.BlockCksum {
+		var total [cos.SizeofI64]byte
+		if _, err := w.Write(total[:]); err != nil { // reserve for total length
+			return err
+		}
+		bw = newBlkWriter(w)
+		w = bw
+	}
 	if opts.Compress {
-		zw = lz4.NewWriter(w)
-		errN := zw.Apply(lz4.BlockSizeOption(lz4BufferSize))
-		debug.AssertNoErr(errN)
+		zw = allocZW(w)
 		w = zw
 	}
 	if opts.Checksum {
@@ -80,20 +181,27 @@ func Encode(ws cos.WriterAt, v any, opts Options) error {
 		cos.Assert(h.Size() == cos.SizeXXHash64)
 		w = io.MultiWriter(h, w)
 	}
+	if opts.annotated() {
+		if err := writeMeta(w, &opts); err != nil {
+			return err
+		}
+	}
 
 	//
 	// 2. data
 	//
-	var (
-		encoder      = cos.JSON.NewEncoder(w)
-		errEn, errCl error
-	)
-	if opts.Indent {
-		encoder.SetIndent("", "  ")
+	var errEn, errCl error
+	switch {
+	case opts.rawLen():
+		errEn = encodeRawLen(w, zw, v, &opts)
+	case opts.Format == FmtMsgPack:
+		errEn = encodeMsgp(w, v)
+	default:
+		errEn = encodeJSON(w, v, &opts)
 	}
-	errEn = encoder.Encode(v)
 	if zw != nil {
 		errCl = zw.Close()
+		freeZW(zw)
 	}
 	if errEn != nil {
 		return errEn
@@ -101,6 +209,16 @@ func Encode(ws cos.WriterAt, v any, opts Options) error {
 	if errCl != nil {
 		return errCl
 	}
+	if bw != nil {
+		if err := bw.flush(); err != nil {
+			return err
+		}
+		var total [cos.SizeofI64]byte
+		binary.BigEndian.PutUint64(total[:], uint64(bw.total))
+		if _, err := ws.WriteAt(total[:], int64(off)); err != nil {
+			return err
+		}
+	}
 
 	//
 	// 3. checksum
@@ -113,54 +231,186 @@ func Encode(ws cos.WriterAt, v any, opts Options) error {
 	return nil
 }
 
+// Decode never modifies caller's options - it works on a clone
+// (e.g., to override compression and checksumming from the signature flags).
 func Decode(r io.Reader, v any, opts Options, tag string) (*cos.Cksum, error) {
+	if !verbose.Load() {
+		return decode(r, v, opts.Clone(), tag, nil)
+	}
+	var (
+		di dinfo
+		cr = &cntReader{r: r}
+	)
+	cksum, err := decode(cr, v, opts.Clone(), tag, &di)
+	if err == nil {
+		return cksum, nil
+	}
+	// checksum and version errors are self-explanatory (and include the tag)
+	var (
+		errU *ErrUnsupportedMetaVersion
+		errC *ErrJspCompatibleVersion
+		errS *ErrBadSignature
+	)
+	if cos.IsErrBadCksum(err) || errors.As(err, &errU) || errors.As(err, &errC) || errors.As(err, &errS) {
+		return nil, err
+	}
+	return nil, &ErrDecode{err: err, tag: tag, off: cr.n, jspVer: di.jspVer, metaVer: di.metaVer}
+}
+
+// TryDecode is a best-effort Decode for (legacy) payloads written with unknown options:
+// it tries the candidates in order and returns the first that decodes (and verifies, if checksummed);
+// `v` (a pointer) is updated only upon success.
+func TryDecode(r io.Reader, v any, candidates []Options, tag string) (*cos.Cksum, Options, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return nil, Options{}, fmt.Errorf("jsp: %s: expecting non-nil pointer, got %T", tag, v)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, Options{}, err
+	}
+	for i := range candidates {
+		tmp := reflect.New(rv.Elem().Type())
+		cksum, err := Decode(bytes.NewReader(b), tmp.Interface(), candidates[i], tag)
+		if err == nil {
+			rv.Elem().Set(tmp.Elem())
+			return cksum, candidates[i], nil
+		}
+		if i == len(candidates)-1 {
+			return nil, Options{}, fmt.Errorf("jsp: %s: none of the %d candidate options worked, last error: %w", tag, len(candidates), err)
+		}
+	}
+	return nil, Options{}, fmt.Errorf("jsp: %s: no candidate options", tag)
+}
+
+func decode(r io.Reader, v any, opts Options, tag string, di *dinfo) (*cos.Cksum, error) {
+	if opts.plain() && opts.MaxDecodedSize == 0 {
+		if opts.Annotate != "" {
+			var err error
+			if r, err = stripMeta(r); err != nil {
+				return nil, err
+			}
+		}
+		dr := newDepthReader(&opts)
+		return nil, dr.check(cos.JSON.NewDecoder(dr.wrap(r)).Decode(v)) // fast path
+	}
 	if opts.Signature {
-		var (
-			prefix  [prefLen]byte
-			metaVer uint32
-		)
-		if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		if err := readPrefix(r, &opts, tag, di); err != nil {
 			return nil, err
 		}
-		l := len(signature)
-		debug.Assert(l < cos.SizeofI64)
-		if signature != string(prefix[:l]) {
-			return nil, &ErrBadSignature{tag, string(prefix[:l]), signature}
-		}
-		jspVer := prefix[l]
-		if jspVer != Metaver {
-			return nil, newErrVersion("jsp", uint32(jspVer), Metaver)
-		}
-		metaVer = binary.BigEndian.Uint32(prefix[cos.SizeofI64:])
-		if metaVer != opts.Metaver {
-			if opts.OldMetaverOk == 0 || metaVer > opts.Metaver || metaVer < opts.OldMetaverOk {
-				// _not_ backward compatible
-				return nil, newErrVersion(tag, metaVer, opts.Metaver)
+	}
+	if opts.Parity {
+		var err error
+		if r, err = readParity(r, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	var lr *limReader
+	if opts.MaxDecodedSize > 0 {
+		lr = &limReader{limit: opts.MaxDecodedSize, remain: opts.MaxDecodedSize}
+	}
+	dr := newDepthReader(&opts)
+	cksum, err := decodeBody(r, v, opts, tag, lr, dr)
+	if lr != nil && lr.err != nil {
+		return nil, lr.err // takes precedence
+	}
+	if err = dr.check(err); err != nil {
+		return nil, err
+	}
+	return cksum, nil
+}
+
+// read and validate signature prefix; override opts from the stored flags
+func readPrefix(r io.Reader, opts *Options, tag string, di *dinfo) error {
+	var (
+		prefix  [prefLen]byte
+		metaVer uint32
+	)
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return err
+	}
+	l := len(signature)
+	debug.Assert(l < cos.SizeofI64)
+	if signature != string(prefix[:l]) {
+		return &ErrBadSignature{tag, string(prefix[:l]), signature}
+	}
+	jspVer := prefix[l]
+	if jspVer != Metaver && jspVer != MetaverExt {
+		return newErrVersion("jsp", uint32(jspVer), MetaverExt)
+	}
+	metaVer = binary.BigEndian.Uint32(prefix[cos.SizeofI64:])
+	if di != nil {
+		di.jspVer, di.metaVer = jspVer, metaVer
+	}
+	if metaVer != opts.Metaver {
+		if opts.OldMetaverOk == 0 || metaVer > opts.Metaver || metaVer < opts.OldMetaverOk {
+			// _not_ backward compatible
+			return newErrVersion(tag, metaVer, opts.Metaver)
+		}
+		// backward compatible
+		if opts.OnVersionSkew != nil {
+			if err := opts.OnVersionSkew(tag, metaVer, opts.Metaver); err != nil {
+				return err
 			}
-			// backward compatible
+		} else {
 			erw := newErrVersion(tag, metaVer, opts.Metaver, opts.OldMetaverOk)
 			nlog.Warningln(erw)
 		}
-		flags := binary.BigEndian.Uint32(prefix[cos.SizeofI64+cos.SizeofI32:])
-		opts.Compress = flags&(1<<0) != 0
-		opts.Checksum = flags&(1<<1) != 0
 	}
+	flags := binary.BigEndian.Uint32(prefix[cos.SizeofI64+cos.SizeofI32:])
+	if unknown := flags & flagsMask &^ knownFlags(jspVer); unknown != 0 {
+		return fmt.Errorf("jsp: %q: unknown flags %#x (jsp v%d)", tag, unknown, jspVer)
+	}
+	opts.setFlags(flags)
+	return nil
+}
+
+func (opts *Options) setFlags(flags uint32) {
+	opts.Compress = flags&flagCompress != 0
+	opts.Checksum = flags&flagChecksum != 0
+	opts.BlockCksum = flags&flagBlkCksum != 0
+	opts.Parity = flags&flagParity != 0
+	opts.hasMeta = flags&flagAnnotate != 0
+	opts.Format = FmtJSON
+	if flags&flagMsgPack != 0 {
+		opts.Format = FmtMsgPack
+	}
+	opts.Kind = uint8(flags >> kindShift)
+}
 
+func decodeBody(r io.Reader, v any, opts Options, tag string, lr *limReader, dr *depthReader) (*cos.Cksum, error) {
+	if opts.BlockCksum {
+		return withBlkCksum(r, v, opts, tag, lr, dr)
+	}
 	if opts.Checksum {
-		return withChecksum(r, v, opts, tag)
+		return withChecksum(r, v, opts, tag, lr, dr)
 	}
 	// otherwise, decode without checksum
 	if opts.Compress {
-		r = lz4.NewReader(r)
+		var err error
+		if r, err = newZR(r, lr); err != nil {
+			return nil, err
+		}
 	}
-	if err := cos.JSON.NewDecoder(r).Decode(v); err != nil {
+	r = lr.wrap(r)
+	if opts.Format == FmtMsgPack {
+		return nil, decodeMsgp(r, v)
+	}
+	if opts.hasMeta || (!opts.Signature && !opts.Compress && opts.Annotate != "") {
+		var err error
+		if r, err = stripMeta(r); err != nil {
+			return nil, err
+		}
+	}
+	if err := cos.JSON.NewDecoder(dr.wrap(r)).Decode(v); err != nil {
 		return nil, err
 	}
 
 	return nil, nil
 }
 
-func withChecksum(r io.Reader, v any, opts Options, tag string) (*cos.Cksum, error) {
+func withChecksum(r io.Reader, v any, opts Options, tag string, lr *limReader, dr *depthReader) (*cos.Cksum, error) {
 	var cksum [cos.SizeXXHash64]byte
 	if _, err := io.ReadFull(r, cksum[:]); err != nil {
 		return nil, err
@@ -168,19 +418,28 @@ func withChecksum(r io.Reader, v any, opts Options, tag string) (*cos.Cksum, err
 
 	expectedCksum := binary.BigEndian.Uint64(cksum[:])
 	if opts.Compress {
-		r = lz4.NewReader(r)
+		var err error
+		if r, err = newZR(r, lr); err != nil {
+			return nil, err
+		}
 	}
+	r = lr.wrap(r)
 
 	var (
 		h  = onexxh.New64()
 		rr = io.TeeReader(r, h)
 	)
-	if err := cos.JSON.NewDecoder(rr).Decode(v); err != nil {
-		return nil, err
-	}
-
-	if err := drainEOL(rr); err != nil {
-		return nil, err
+	if opts.Format == FmtMsgPack {
+		if err := decodeMsgp(rr, v); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := cos.JSON.NewDecoder(dr.wrap(rr)).Decode(v); err != nil {
+			return nil, err
+		}
+		if err := drainEOL(rr); err != nil {
+			return nil, err
+		}
 	}
 
 	actual := h.Sum(nil)
@@ -192,6 +451,26 @@ func withChecksum(r io.Reader, v any, opts Options, tag string) (*cos.Cksum, err
 	return cos.NewCksum(cos.ChecksumOneXxh, hex.EncodeToString(actual)), nil
 }
 
+func encodeMsgp(w io.Writer, v any) error {
+	e, ok := v.(msgp.Encodable)
+	if !ok {
+		return fmt.Errorf("jsp: %T does not implement msgp.Encodable", v)
+	}
+	mw := msgp.NewWriter(w)
+	if err := e.EncodeMsg(mw); err != nil {
+		return err
+	}
+	return mw.Flush()
+}
+
+func decodeMsgp(r io.Reader, v any) error {
+	d, ok := v.(msgp.Decodable)
+	if !ok {
+		return fmt.Errorf("jsp: %T does not implement msgp.Decodable", v)
+	}
+	return d.DecodeMsg(msgp.NewReader(r))
+}
+
 func drainEOL(r io.Reader) error {
 	var (
 		b      [1]byte
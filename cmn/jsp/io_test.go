@@ -7,17 +7,27 @@ package jsp_test
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"io"
 	"math/rand/v2"
+	"os"
+	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/tools/trand"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	"github.com/pierrec/lz4/v4"
 )
 
 // go test -v -bench=. -tags=debug
@@ -85,6 +95,8 @@ func TestDecodeAndEncode(t *testing.T) {
 		{name: "compress_cksum", v: makeRandStruct(), opts: jsp.Options{Compress: true, Checksum: true}},
 		{name: "cksum_sign", v: makeRandStruct(), opts: jsp.Options{Checksum: true, Signature: true}},
 		{name: "ccs", v: makeRandStruct(), opts: jsp.CCSign(1)},
+		{name: "blk_cksum", v: makeRandStruct(), opts: jsp.Options{Signature: true, BlockCksum: true}},
+		{name: "blk_compress", v: makeRandStruct(), opts: jsp.Options{Signature: true, BlockCksum: true, Compress: true}},
 		{
 			name: "special_char",
 			v:    testStruct{I: 10, S: "abc\ncd]}{", B: []byte{'a', 'b', '\n', 'c', 'd', ']', '}'}},
@@ -144,6 +156,273 @@ func TestDecodeAndEncodeFuzz(t *testing.T) {
 	}
 }
 
+func TestBlockCksum(t *testing.T) {
+	var (
+		lst  = makeLsoRes(4000) // multiple blocks
+		opts = jsp.Options{Signature: true, BlockCksum: true}
+		b    = memsys.PageMM().NewSGL(cos.MiB)
+	)
+	defer b.Free()
+
+	err := jsp.Encode(b, lst, opts)
+	tassert.CheckFatal(t, err)
+	data := b.ReadAll()
+	tassert.Fatalf(t, len(data) > 4*cos.SizeofI64*cos.KiB, "expecting multiple blocks, got %d bytes", len(data))
+
+	var out cmn.LsoRes
+	cksum, err := jsp.Decode(bytes.NewReader(data), &out, opts, "blk")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, cksum != nil, "expecting checksum")
+	tassert.Fatalf(t, len(out.Entries) == len(lst.Entries), "entries: %d vs %d", len(out.Entries), len(lst.Entries))
+
+	// truncated
+	_, err = jsp.Decode(bytes.NewReader(data[:len(data)/2]), &out, opts, "blk")
+	tassert.Fatalf(t, err != nil, "expecting error on truncated input")
+
+	// corrupt the first block: must fail with checksum (not parsing) error
+	data[2*cos.SizeofI64+cos.SizeofI64+100] ^= 0xff
+	_, err = jsp.Decode(bytes.NewReader(data), &out, opts, "blk")
+	tassert.Fatalf(t, cos.IsErrBadCksum(err), "expecting bad checksum, got %v", err)
+}
+
+func TestVerboseErrors(t *testing.T) {
+	var (
+		v    testStruct
+		opts = jsp.Options{Signature: true, Metaver: 1}
+		b    = memsys.PageMM().NewSGL(cos.KiB)
+	)
+	defer b.Free()
+	jsp.SetVerbose(true)
+	defer jsp.SetVerbose(false)
+
+	err := jsp.Encode(b, makeStaticStruct(), opts)
+	tassert.CheckFatal(t, err)
+	data := b.ReadAll()
+	data = data[:len(data)/2] // truncate
+
+	_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "verbose")
+	var errD *jsp.ErrDecode
+	tassert.Fatalf(t, errors.As(err, &errD), "expecting verbose decode error, got %v", err)
+	tassert.Fatalf(t, strings.Contains(err.Error(), "meta-version 1"), "expecting meta-version in %q", err)
+}
+
+// representative (msgp-generated) structure
+func makeLsoRes(num int) *cmn.LsoRes {
+	lst := &cmn.LsoRes{UUID: trand.String(16), ContinuationToken: trand.String(32)}
+	lst.Entries = make(cmn.LsoEntries, num)
+	for i := range num {
+		lst.Entries[i] = &cmn.LsoEnt{
+			Name:     trand.String(40),
+			Checksum: trand.String(16),
+			Atime:    trand.String(20),
+			Version:  strconv.Itoa(i),
+			Size:     rand.Int64(),
+			Copies:   1,
+		}
+	}
+	return lst
+}
+
+func TestMaxDecodedSize(t *testing.T) {
+	for _, opts := range []jsp.Options{
+		{Compress: true},
+		{Compress: true, Checksum: true},
+		{Compress: true, BlockCksum: true},
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				v testStruct
+				b = memsys.PageMM().NewSGL(cos.KiB)
+				s = makeStaticStruct()
+			)
+			defer b.Free()
+			s.B = bytes.Repeat([]byte{'x'}, 64*cos.KiB) // compresses well
+			tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+			data := b.ReadAll()
+
+			opts.MaxDecodedSize = 4 * cos.KiB
+			_, err := jsp.Decode(bytes.NewReader(data), &v, opts, "limit")
+			var errL *jsp.ErrSizeLimit
+			tassert.Fatalf(t, errors.As(err, &errL), "expecting size-limit error, got %v", err)
+
+			opts.MaxDecodedSize = cos.MiB
+			_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "limit")
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, bytes.Equal(v.B, s.B), "decoded mismatch")
+		})
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	for _, opts := range []jsp.Options{
+		jsp.Plain(),
+		jsp.CksumSign(1),
+		jsp.CCSign(1),
+		{Signature: true, Metaver: 1, BlockCksum: true, Compress: true},
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				v testStruct
+				b = memsys.PageMM().NewSGL(cos.KiB)
+				s = makeStaticStruct()
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+			data := b.ReadAll()
+
+			jr, err := jsp.NewReader(io.NopCloser(bytes.NewReader(data)), opts, "reader")
+			tassert.CheckFatal(t, err)
+			raw, err := io.ReadAll(jr)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, jr.Close())
+			tassert.CheckFatal(t, cos.JSON.Unmarshal(raw, &v))
+			tassert.Errorf(t, reflect.DeepEqual(s, v), "structs are not equal: (got: %+v, expected: %+v)", v, s)
+
+			if !opts.Checksum {
+				return
+			}
+			// corrupt the last byte and expect checksum error on Close
+			data[len(data)-1] ^= 0xff
+			jr, err = jsp.NewReader(io.NopCloser(bytes.NewReader(data)), opts, "reader")
+			tassert.CheckFatal(t, err)
+			io.Copy(io.Discard, jr)
+			err = jr.Close()
+			tassert.Fatalf(t, err != nil, "expecting error on Close")
+		})
+	}
+}
+
+func TestLayout(t *testing.T) {
+	var (
+		b    = memsys.PageMM().NewSGL(cos.KiB)
+		s    = makeStaticStruct()
+		opts = jsp.CksumSign(1)
+	)
+	defer b.Free()
+	tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+	data := b.ReadAll()
+
+	l := jsp.LayoutOf(opts)
+	tassert.Fatalf(t, l.PrefixLen == jsp.PrefixLen && l.ChecksumOff == jsp.ChecksumOffset && l.ChecksumLen == jsp.ChecksumLen,
+		"unexpected layout %+v", l)
+	tassert.Fatalf(t, jsp.Is(data[:l.PrefixLen]), "expecting jsp prefix")
+
+	// the checksum written at ChecksumOffset is xxhash64 of the payload
+	var (
+		stored   = binary.BigEndian.Uint64(data[l.ChecksumOff : l.ChecksumOff+l.ChecksumLen])
+		computed = onexxh.Checksum64(data[l.PayloadOff:])
+	)
+	tassert.Errorf(t, stored == computed, "checksum at offset %d: stored %x vs computed %x", l.ChecksumOff, stored, computed)
+
+	// payload is plain JSON
+	var v testStruct
+	tassert.CheckFatal(t, cos.JSON.Unmarshal(data[l.PayloadOff:], &v))
+	tassert.Errorf(t, reflect.DeepEqual(s, v), "structs are not equal: (got: %+v, expected: %+v)", v, s)
+
+	// plain: nothing but the payload
+	l = jsp.LayoutOf(jsp.Plain())
+	tassert.Errorf(t, l == jsp.Layout{}, "unexpected plain layout %+v", l)
+}
+
+func TestDecodeFields(t *testing.T) {
+	for _, opts := range []jsp.Options{
+		jsp.Plain(),
+		jsp.CCSign(1),
+		{Signature: true, Metaver: 1, BlockCksum: true},
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				b   = memsys.PageMM().NewSGL(cos.KiB)
+				s   = makeStaticStruct()
+				str string
+				i64 struct {
+					I64 int64 `json:"int64"`
+				}
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+			data := b.ReadAll()
+
+			want := map[string]any{"zero": &str, "ST": &i64}
+			tassert.CheckFatal(t, jsp.DecodeFields(bytes.NewReader(data), opts, "fields", want))
+			tassert.Errorf(t, str == s.S, "string: got %q, expected %q", str, s.S)
+			tassert.Errorf(t, i64.I64 == s.ST.I64, "int64: got %d, expected %d", i64.I64, s.ST.I64)
+
+			if !opts.Checksum {
+				return
+			}
+			// corrupt the (skipped) map and expect checksum error
+			data[len(data)-3] ^= 0x01
+			err := jsp.DecodeFields(bytes.NewReader(data), opts, "fields", want)
+			tassert.Fatalf(t, err != nil, "expecting checksum error")
+		})
+	}
+}
+
+func TestTryDecode(t *testing.T) {
+	var (
+		candidates = []jsp.Options{jsp.CCSign(1), {Checksum: true}, {Compress: true}, jsp.Plain()}
+		legacy     = jsp.Options{Checksum: true} // no signature
+		b          = memsys.PageMM().NewSGL(cos.KiB)
+		s          = makeStaticStruct()
+		v          testStruct
+	)
+	defer b.Free()
+	tassert.CheckFatal(t, jsp.Encode(b, s, legacy))
+	data := b.ReadAll()
+
+	cksum, opts, err := jsp.TryDecode(bytes.NewReader(data), &v, candidates, "legacy")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cksum != nil, "expecting checksum")
+	tassert.Errorf(t, opts.Checksum && !opts.Signature && !opts.Compress, "wrong options %s", opts.String())
+	tassert.Errorf(t, reflect.DeepEqual(s, v), "structs are not equal: (got: %+v, expected: %+v)", v, s)
+
+	// corrupted: none verifies, and v stays intact
+	data[len(data)-2] ^= 0xff
+	var w testStruct
+	_, _, err = jsp.TryDecode(bytes.NewReader(data), &w, candidates, "legacy")
+	tassert.Fatalf(t, err != nil, "expecting error")
+	tassert.Errorf(t, reflect.DeepEqual(w, testStruct{}), "expecting zero value, got %+v", w)
+}
+
+func TestMsgPack(t *testing.T) {
+	tests := []struct {
+		name string
+		opts jsp.Options
+	}{
+		{name: "plain", opts: jsp.Options{Format: jsp.FmtMsgPack}},
+		{name: "cksum", opts: jsp.Options{Checksum: true, Format: jsp.FmtMsgPack}},
+		{name: "compress", opts: jsp.Options{Compress: true, Format: jsp.FmtMsgPack}},
+		{name: "ccs", opts: jsp.Options{Metaver: 1, Compress: true, Checksum: true, Signature: true, Format: jsp.FmtMsgPack}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				v, orig = cmn.LsoRes{}, makeLsoRes(100)
+				b       = memsys.PageMM().NewSGL(cos.MiB)
+			)
+			defer b.Free()
+
+			err := jsp.Encode(b, orig, test.opts)
+			tassert.CheckFatal(t, err)
+
+			opts := test.opts
+			if opts.Signature {
+				opts.Format = jsp.FmtJSON // must self-select
+			}
+			_, err = jsp.Decode(b, &v, opts, "test")
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, reflect.DeepEqual(orig, &v), "structs are not equal")
+		})
+	}
+
+	// not msgp-encodable
+	b := memsys.PageMM().NewSGL(cos.KiB)
+	defer b.Free()
+	err := jsp.Encode(b, makeRandStruct(), jsp.Options{Format: jsp.FmtMsgPack})
+	tassert.Fatalf(t, err != nil, "expected error encoding non-msgp type")
+}
+
 func BenchmarkEncode(b *testing.B) {
 	benches := []struct {
 		name string
@@ -176,6 +455,80 @@ func BenchmarkEncode(b *testing.B) {
 	}
 }
 
+// pooled (jsp.Encode) vs. per-call encoders (the way it used to be)
+// go test -bench=EncodeSmall -benchmem
+func BenchmarkEncodeSmall(b *testing.B) {
+	var (
+		v    = makeStaticStruct()
+		opts = jsp.Options{Compress: true}
+		mmsa = memsys.PageMM()
+	)
+	b.Run("pooled", func(b *testing.B) {
+		body := mmsa.NewSGL(cos.KiB * 64)
+		defer body.Free()
+		b.ReportAllocs()
+		for b.Loop() {
+			err := jsp.Encode(body, v, opts)
+			tassert.CheckFatal(b, err)
+			body.Reset()
+		}
+	})
+	b.Run("unpooled", func(b *testing.B) {
+		body := mmsa.NewSGL(cos.KiB * 64)
+		defer body.Free()
+		b.ReportAllocs()
+		for b.Loop() {
+			zw := lz4.NewWriter(body)
+			err := zw.Apply(lz4.BlockSizeOption(lz4.Block64Kb))
+			tassert.CheckFatal(b, err)
+			err = cos.JSON.NewEncoder(zw).Encode(v)
+			tassert.CheckFatal(b, err)
+			err = zw.Close()
+			tassert.CheckFatal(b, err)
+			body.Reset()
+		}
+	})
+}
+
+// go test -bench=Format -benchmem
+func BenchmarkFormat(b *testing.B) {
+	var (
+		lst  = makeLsoRes(1000)
+		mmsa = memsys.PageMM()
+	)
+	for _, bench := range []struct {
+		name string
+		opts jsp.Options
+	}{
+		{name: "json", opts: jsp.CksumSign(1)},
+		{name: "msgpack", opts: jsp.Options{Metaver: 1, Checksum: true, Signature: true, Format: jsp.FmtMsgPack}},
+	} {
+		b.Run("encode-"+bench.name, func(b *testing.B) {
+			body := mmsa.NewSGL(cos.MiB)
+			defer body.Free()
+			b.ReportAllocs()
+			for b.Loop() {
+				err := jsp.Encode(body, lst, bench.opts)
+				tassert.CheckFatal(b, err)
+				body.Reset()
+			}
+		})
+		b.Run("decode-"+bench.name, func(b *testing.B) {
+			body := mmsa.NewSGL(cos.MiB)
+			err := jsp.Encode(body, lst, bench.opts)
+			tassert.CheckFatal(b, err)
+			network := body.ReadAll()
+			body.Free()
+			b.ReportAllocs()
+			for b.Loop() {
+				var v cmn.LsoRes
+				_, err := jsp.Decode(bytes.NewReader(network), &v, bench.opts, "benchmark")
+				tassert.CheckFatal(b, err)
+			}
+		})
+	}
+}
+
 func BenchmarkDecode(b *testing.B) {
 	benches := []struct {
 		name string
@@ -213,3 +566,665 @@ func BenchmarkDecode(b *testing.B) {
 		})
 	}
 }
+
+func TestIs(t *testing.T) {
+	mmsa := memsys.PageMM()
+	b := mmsa.NewSGL(cos.KiB)
+	defer b.Free()
+
+	err := jsp.Encode(b, makeRandStruct(), jsp.CCSign(1))
+	tassert.CheckFatal(t, err)
+	data := b.ReadAll()
+	tassert.Fatalf(t, jsp.Is(data), "expected jsp signature")
+	tassert.Fatalf(t, string(data[:len(jsp.Signature())]) == jsp.Signature(), "signature mismatch")
+	tassert.Fatalf(t, data[len(jsp.Signature())] == jsp.MetaVersion(), "jsp version mismatch")
+
+	tassert.Fatalf(t, !jsp.Is(data[:len(jsp.Signature())]), "truncated prefix must not be recognized")
+	tassert.Fatalf(t, !jsp.Is([]byte(`{"a":1}`)), "plain json must not be recognized")
+}
+
+// v3 for compression and/or checksum (and any Kind), v4 when any of the extended flags is set
+func TestJspVersion(t *testing.T) {
+	sign := jsp.Options{Signature: true, Metaver: 1}
+	for _, test := range []struct {
+		name string
+		opts jsp.Options
+		ver  byte
+	}{
+		{"sign", sign, jsp.Metaver},
+		{"ccs", jsp.CCSign(1), jsp.Metaver},
+		{"kind", jsp.Options{Signature: true, Metaver: 1, Checksum: true, Kind: jsp.KindBMD}, jsp.Metaver},
+		{"msgpack", jsp.Options{Signature: true, Metaver: 1, Checksum: true, Format: jsp.FmtMsgPack}, jsp.MetaverExt},
+		{"blk-cksum", jsp.Options{Signature: true, Metaver: 1, BlockCksum: true}, jsp.MetaverExt},
+		{"parity", jsp.Options{Signature: true, Metaver: 1, Checksum: true, Parity: true}, jsp.MetaverExt},
+		{"annotate", jsp.Options{Signature: true, Metaver: 1, Indent: true, Annotate: "note"}, jsp.MetaverExt},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				out cmn.LsoRes
+				b   = memsys.PageMM().NewSGL(cos.KiB)
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, jsp.Encode(b, makeLsoRes(10), test.opts))
+			data := b.ReadAll()
+			tassert.Fatalf(t, data[len(jsp.Signature())] == test.ver, "expecting jsp v%d, got v%d", test.ver, data[len(jsp.Signature())])
+			tassert.Errorf(t, jsp.Is(data), "expecting jsp prefix")
+			_, err := jsp.Decode(bytes.NewReader(data), &out, sign, test.name)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, len(out.Entries) == 10, "expecting 10 entries, got %d", len(out.Entries))
+
+			// unknown low-order flag bits
+			bad := slices.Clone(data)
+			bad[jsp.PrefixLen-2] |= 0x80
+			_, err = jsp.Decode(bytes.NewReader(bad), &out, sign, test.name)
+			tassert.Errorf(t, err != nil, "expecting unknown flags rejected")
+
+			// unsupported jsp version
+			bad = slices.Clone(data)
+			bad[len(jsp.Signature())] = jsp.MetaverExt + 1
+			tassert.Errorf(t, !jsp.Is(bad), "not expecting jsp v%d recognized", jsp.MetaverExt+1)
+			_, err = jsp.Decode(bytes.NewReader(bad), &out, sign, test.name)
+			tassert.Errorf(t, err != nil, "expecting jsp v%d rejected", jsp.MetaverExt+1)
+		})
+	}
+
+	// v3 knows only compression and checksum
+	b := memsys.PageMM().NewSGL(cos.KiB)
+	defer b.Free()
+	tassert.CheckFatal(t, jsp.Encode(b, makeLsoRes(10), jsp.Options{Signature: true, Metaver: 1, Format: jsp.FmtMsgPack}))
+	data := b.ReadAll()
+	data[len(jsp.Signature())] = jsp.Metaver
+	var out cmn.LsoRes
+	_, err := jsp.Decode(bytes.NewReader(data), &out, sign, "v3")
+	tassert.Errorf(t, err != nil, "expecting extended flags rejected in jsp v%d", jsp.Metaver)
+}
+
+// all-off fast path: same bytes as the (plain) JSON encoder
+func TestPlainFastPath(t *testing.T) {
+	mmsa := memsys.PageMM()
+	for _, v := range []testStruct{{}, makeRandStruct(), {S: "abc\ncd]}{", M: map[string]string{"b": "1", "a": "2"}}} {
+		for _, indent := range []bool{false, true} {
+			var (
+				expected bytes.Buffer
+				b        = mmsa.NewSGL(cos.KiB)
+				opts     = jsp.Options{Indent: indent, Metaver: 1}
+				enc      = cos.JSON.NewEncoder(&expected)
+			)
+			if indent {
+				enc.SetIndent("", "  ")
+			}
+			tassert.CheckFatal(t, enc.Encode(v))
+
+			err := jsp.Encode(b, v, opts)
+			tassert.CheckFatal(t, err)
+			actual := b.Bytes()
+			tassert.Fatalf(t, bytes.Equal(actual, expected.Bytes()), "indent=%t: %q vs %q", indent, actual, expected.Bytes())
+
+			var out testStruct
+			_, err = jsp.Decode(bytes.NewReader(actual), &out, opts, "test")
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+			b.Free()
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	const note = "test v1.0 \"config\""
+	mmsa := memsys.PageMM()
+	for _, test := range []struct {
+		name      string
+		opts      jsp.Options
+		annotated bool
+	}{
+		{"plain", jsp.Options{Indent: true, Annotate: note}, true},
+		{"sign", jsp.Options{Indent: true, Signature: true, Metaver: 1, Annotate: note}, true},
+		{"no-indent", jsp.Options{Annotate: note}, false},
+		{"cksum", jsp.Options{Indent: true, Checksum: true, Annotate: note}, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				v   = makeRandStruct()
+				out testStruct
+				b   = mmsa.NewSGL(cos.KiB)
+			)
+			defer b.Free()
+			err := jsp.Encode(b, v, test.opts)
+			tassert.CheckFatal(t, err)
+
+			payload := b.Bytes()[jsp.LayoutOf(test.opts).PrefixLen:]
+			if test.opts.Checksum {
+				payload = payload[jsp.ChecksumLen:]
+			}
+			has := bytes.HasPrefix(payload, []byte(`{"_meta":{"note":"test v1.0 \"config\"","time":`))
+			tassert.Fatalf(t, has == test.annotated, "annotated: expected %t, got %q", test.annotated, payload)
+
+			_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, test.opts, "test")
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+
+			// signed: the prefix flag (not the caller) tells Decode to strip
+			if test.opts.Signature {
+				opts := test.opts
+				opts.Annotate = ""
+				out = testStruct{}
+				_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+			}
+		})
+	}
+}
+
+// un-annotated payload that happens to start with `{"_meta":` must decode as is
+func TestAnnotateNoStrip(t *testing.T) {
+	type (
+		meta struct {
+			Note string `json:"note"`
+		}
+		withMeta struct {
+			Meta meta   `json:"_meta"`
+			Name string `json:"name"`
+			N    int    `json:"n"`
+		}
+	)
+	mmsa := memsys.PageMM()
+	v := withMeta{Meta: meta{Note: "user data"}, Name: "xyz", N: 42}
+	for _, opts := range []jsp.Options{
+		{},
+		{Indent: true},
+		{Signature: true, Metaver: 1},
+		{Signature: true, Metaver: 1, Indent: true},
+	} {
+		b := mmsa.NewSGL(cos.KiB)
+		tassert.CheckFatal(t, jsp.Encode(b, v, opts))
+		tassert.Fatalf(t, bytes.Contains(b.Bytes(), []byte(`"_meta":`)), "%s: expecting _meta", opts.String())
+
+		var out withMeta
+		_, err := jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, out == v, "%s: expected %+v, got %+v", opts.String(), v, out)
+		b.Free()
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	m := make(map[string]any, 1000)
+	for i := range 1000 {
+		k := trand.String(8)
+		switch i % 3 {
+		case 0:
+			m[k] = rand.Float64() * 1e6
+		case 1:
+			m[k] = map[string]int{trand.String(4): i, trand.String(4): -i}
+		default:
+			m[k] = trand.String(16)
+		}
+	}
+	mmsa := memsys.PageMM()
+	for _, opts := range []jsp.Options{
+		{Deterministic: true},
+		{Deterministic: true, Indent: true, Annotate: "test"},
+		{Deterministic: true, Compress: true, Checksum: true, Signature: true, Metaver: 1},
+		{Deterministic: true, BlockCksum: true, Signature: true, Metaver: 1},
+	} {
+		var (
+			b1 = mmsa.NewSGL(cos.KiB)
+			b2 = mmsa.NewSGL(cos.KiB)
+		)
+		tassert.CheckFatal(t, jsp.Encode(b1, m, opts))
+		tassert.CheckFatal(t, jsp.Encode(b2, m, opts))
+		tassert.Fatalf(t, bytes.Equal(b1.Bytes(), b2.Bytes()), "%s: not deterministic", opts.String())
+
+		var out map[string]any
+		_, err := jsp.Decode(bytes.NewReader(b1.Bytes()), &out, opts, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(out) == len(m), "%s: expected %d keys, got %d", opts.String(), len(m), len(out))
+		b1.Free()
+		b2.Free()
+	}
+
+	// msgpack is not
+	b := mmsa.NewSGL(cos.KiB)
+	defer b.Free()
+	err := jsp.Encode(b, makeRandStruct(), jsp.Options{Deterministic: true, Format: jsp.FmtMsgPack})
+	tassert.Fatalf(t, err != nil, "expected error (deterministic msgpack)")
+}
+
+func TestStream(t *testing.T) {
+	const num = 100
+	mmsa := memsys.PageMM()
+	for _, opts := range []jsp.Options{jsp.Plain(), jsp.CCSign(1), {Signature: true, BlockCksum: true, Compress: true}} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				b    = mmsa.NewSGL(cos.KiB)
+				recs = make([]testStruct, num)
+				enc  = jsp.NewEncoder(b, opts)
+			)
+			defer b.Free()
+			for i := range recs {
+				recs[i] = makeRandStruct()
+				tassert.CheckFatal(t, enc.Write(recs[i]))
+			}
+			tassert.CheckFatal(t, enc.Close())
+			tassert.Fatalf(t, enc.Write(recs[0]) != nil, "expected error writing to closed encoder")
+
+			full := b.Bytes()
+			dec := jsp.DecodeStream(bytes.NewReader(full), opts, "test")
+			for i := range recs {
+				var out testStruct
+				tassert.CheckFatal(t, dec.Next(&out))
+				tassert.Fatalf(t, out.equal(recs[i]), "record %d: %+v vs %+v", i, out, recs[i])
+			}
+			var out testStruct
+			err := dec.Next(&out)
+			tassert.Fatalf(t, err == io.EOF, "expected io.EOF, got %v", err)
+
+			// truncated last frame
+			dec = jsp.DecodeStream(bytes.NewReader(full[:len(full)-3]), opts, "test")
+			for range num - 1 {
+				tassert.CheckFatal(t, dec.Next(&out))
+			}
+			err = dec.Next(&out)
+			tassert.Fatalf(t, err == io.ErrUnexpectedEOF, "expected io.ErrUnexpectedEOF, got %v", err)
+		})
+	}
+}
+
+func TestStoreRawLen(t *testing.T) {
+	mmsa := memsys.PageMM()
+	for _, opts := range []jsp.Options{
+		{Signature: true, Compress: true, StoreRawLen: true},
+		{Signature: true, Compress: true, Checksum: true, StoreRawLen: true, Metaver: 1},
+		{Signature: true, Compress: true, BlockCksum: true, StoreRawLen: true},
+		{Signature: true, Compress: true, Checksum: true}, // not recorded
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				v   = makeRandStruct()
+				raw bytes.Buffer
+				b   = mmsa.NewSGL(cos.KiB)
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, cos.JSON.NewEncoder(&raw).Encode(v))
+
+			// twice, to exercise pooled writers
+			for range 2 {
+				b.Reset()
+				tassert.CheckFatal(t, jsp.Encode(b, v, opts))
+			}
+			info, err := jsp.DecodeInfo(bytes.NewReader(b.Bytes()), jsp.Options{Signature: true, Metaver: opts.Metaver}, "test")
+			tassert.CheckFatal(t, err)
+			exp := int64(raw.Len())
+			if !opts.StoreRawLen {
+				exp = -1
+			}
+			tassert.Fatalf(t, info.RawLen == exp, "expected raw length %d, got %d", exp, info.RawLen)
+			tassert.Fatalf(t, info.Opts.Compress && info.Opts.Checksum == opts.Checksum, "unexpected stored options %s", info.Opts.String())
+
+			var out testStruct
+			_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+
+			// size limit
+			lim := opts
+			lim.MaxDecodedSize = int64(raw.Len()) - 1
+			_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, lim, "test")
+			var errL *jsp.ErrSizeLimit
+			tassert.Fatalf(t, errors.As(err, &errL), "expected size-limit error, got %v", err)
+		})
+	}
+}
+
+func TestDecodeOptsUnchanged(t *testing.T) {
+	var (
+		v    testStruct
+		b    = memsys.PageMM().NewSGL(cos.KiB)
+		opts = jsp.Options{Signature: true, Metaver: 1}
+	)
+	defer b.Free()
+
+	err := jsp.Encode(b, makeRandStruct(), jsp.CCSign(1))
+	tassert.CheckFatal(t, err)
+
+	clone := opts.Clone()
+	_, err = jsp.Decode(b, &v, opts, "test")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, reflect.DeepEqual(opts, clone), "options modified: %+v vs %+v", opts, clone)
+}
+
+func TestOptionsString(t *testing.T) {
+	plain, cc, cs := jsp.Plain(), jsp.CCSign(2), jsp.CksumSign(1)
+	tests := []struct {
+		opts *jsp.Options
+		exp  string
+	}{
+		{&plain, "jsp[plain]"},
+		{&cc, "jsp[sign,cksum,lz4,v2]"},
+		{&cs, "jsp[sign,cksum,v1]"},
+	}
+	for _, test := range tests {
+		s := test.opts.String()
+		tassert.Errorf(t, s == test.exp, "expected %q, got %q", test.exp, s)
+	}
+}
+
+func TestEncodeFileAtomic(t *testing.T) {
+	var (
+		fpath  = filepath.Join(t.TempDir(), "meta")
+		opts   = jsp.Options{Signature: true, Checksum: true, Metaver: 1}
+		v1, v2 = makeRandStruct(), makeRandStruct()
+		out    testStruct
+	)
+	jsp.SetFsyncMeta(true)
+	defer jsp.SetFsyncMeta(false)
+
+	tassert.CheckFatal(t, jsp.EncodeFileAtomic(fpath, v1, opts))
+	tassert.CheckFatal(t, jsp.EncodeFileAtomic(fpath, v2, opts))
+	_, err := jsp.DecodeFileSafe(fpath, &out, opts)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, out.equal(v2), "structs are not equal: %+v vs %+v", out, v2)
+
+	// corrupt the payload (flip the case of a string value's last letter) - expecting the previous version from .bak
+	b, err := os.ReadFile(fpath)
+	tassert.CheckFatal(t, err)
+	i := bytes.LastIndexByte(b, '"')
+	tassert.Fatalf(t, i > 0, "no string values?")
+	b[i-1] ^= 0x20
+	tassert.CheckFatal(t, os.WriteFile(fpath, b, 0o644))
+
+	out = testStruct{}
+	_, err = jsp.DecodeFileSafe(fpath, &out, opts)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, out.equal(v1), "expected previous version: %+v vs %+v", out, v1)
+}
+
+func TestKind(t *testing.T) {
+	const unknown = jsp.KindUser + 99
+	for _, test := range []struct {
+		kind uint8
+		exp  string
+	}{
+		{jsp.KindBMD, "bucket-metadata (BMD) v5 (jsp v3, cksum, lz4)"},
+		{unknown, "kind-227 v5 (jsp v3, cksum, lz4)"},
+		{jsp.KindNone, "unknown kind v5 (jsp v3, cksum, lz4)"},
+	} {
+		var (
+			v    = makeRandStruct()
+			out  testStruct
+			b    = memsys.PageMM().NewSGL(cos.KiB)
+			opts = jsp.CCSign(5)
+		)
+		opts.Kind = test.kind
+		tassert.CheckFatal(t, jsp.Encode(b, v, opts))
+
+		h, err := jsp.Sniff(bytes.NewReader(b.Bytes()), "test")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, h.Opts.Kind == test.kind, "expected kind %d, got %d", test.kind, h.Opts.Kind)
+		tassert.Errorf(t, h.String() == test.exp, "expected %q, got %q", test.exp, h.String())
+
+		info, err := jsp.DecodeInfo(bytes.NewReader(b.Bytes()), jsp.Options{Signature: true, Metaver: 5}, "test")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, info.Opts.Kind == test.kind, "expected kind %d, got %d", test.kind, info.Opts.Kind)
+
+		// (decoding is kind-agnostic)
+		_, err = jsp.Decode(b, &out, jsp.CCSign(5), "test")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+		b.Free()
+	}
+}
+
+func TestOnVersionSkew(t *testing.T) {
+	var (
+		v       = makeRandStruct()
+		out     testStruct
+		b       = memsys.PageMM().NewSGL(cos.KiB)
+		got     []uint32
+		errSkew = errors.New("rejected")
+	)
+	defer b.Free()
+	tassert.CheckFatal(t, jsp.Encode(b, v, jsp.CCSign(2)))
+
+	opts := jsp.CCSign(3)
+	opts.OldMetaverOk = 1
+	opts.OnVersionSkew = func(tag string, have, want uint32) error {
+		tassert.Errorf(t, tag == "test", "unexpected tag %q", tag)
+		got = append(got, have, want)
+		return nil
+	}
+	_, err := jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, out.equal(v), "structs are not equal: %+v vs %+v", out, v)
+	tassert.Fatalf(t, len(got) == 2 && got[0] == 2 && got[1] == 3, "expected (2, 3), got %v", got)
+
+	// reject
+	opts.OnVersionSkew = func(string, uint32, uint32) error { return errSkew }
+	_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+	tassert.Fatalf(t, errors.Is(err, errSkew), "expected %v, got %v", errSkew, err)
+
+	// incompatible: not called
+	got = got[:0]
+	opts.OldMetaverOk = 0
+	opts.OnVersionSkew = func(_ string, have, want uint32) error { got = append(got, have, want); return nil }
+	_, err = jsp.Decode(bytes.NewReader(b.Bytes()), &out, opts, "test")
+	tassert.Fatalf(t, err != nil && len(got) == 0, "expected version error (and no callback), got %v, %v", err, got)
+}
+
+type intoElem struct {
+	Name string `json:"name"`
+	Opt  string `json:"opt,omitempty"`
+	Size int64  `json:"size"`
+}
+
+func makeIntoPayload(tb testing.TB, n int, opt string, opts jsp.Options) []byte {
+	elems := make([]intoElem, n)
+	for i := range elems {
+		elems[i] = intoElem{Name: "obj-" + strconv.Itoa(i), Opt: opt, Size: int64(i)}
+	}
+	sgl := memsys.PageMM().NewSGL(cos.KiB)
+	defer sgl.Free()
+	tassert.CheckFatal(tb, jsp.Encode(sgl, elems, opts))
+	return sgl.ReadAll()
+}
+
+func TestDecodeInto(t *testing.T) {
+	opts := jsp.CCSign(1)
+	var dst []intoElem
+	_, err := jsp.DecodeInto(bytes.NewReader(makeIntoPayload(t, 100, "stale", opts)), &dst, opts, "into")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(dst) == 100 && dst[99].Opt == "stale", "unexpected %d, %+v", len(dst), dst[len(dst)-1])
+	p := &dst[:1][0]
+
+	// fewer elements, no omitempty field: same backing array, no stale fields
+	_, err = jsp.DecodeInto(bytes.NewReader(makeIntoPayload(t, 50, "", opts)), &dst, opts, "into")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(dst) == 50, "expected 50, got %d", len(dst))
+	tassert.Errorf(t, &dst[0] == p, "expected the backing array to be reused")
+	for i := range dst {
+		tassert.Fatalf(t, dst[i].Opt == "" && dst[i].Size == int64(i), "stale element %d: %+v", i, dst[i])
+	}
+
+	// beyond the previous length (but within capacity)
+	_, err = jsp.DecodeInto(bytes.NewReader(makeIntoPayload(t, 100, "", opts)), &dst, opts, "into")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, &dst[0] == p, "expected the backing array to be reused")
+	tassert.Errorf(t, dst[99].Opt == "", "stale element: %+v", dst[99])
+
+	// stream
+	sgl := memsys.PageMM().NewSGL(cos.KiB)
+	defer sgl.Free()
+	enc := jsp.NewEncoder(sgl, opts)
+	tassert.CheckFatal(t, enc.Write([]intoElem{{Name: "a", Opt: "x"}, {Name: "b", Opt: "y"}}))
+	tassert.CheckFatal(t, enc.Write([]intoElem{{Name: "c"}}))
+	tassert.CheckFatal(t, enc.Close())
+	dec := jsp.DecodeStream(sgl, opts, "into-stream")
+	tassert.CheckFatal(t, jsp.NextInto(dec, &dst))
+	tassert.Fatalf(t, len(dst) == 2 && dst[1].Opt == "y", "unexpected %+v", dst)
+	tassert.CheckFatal(t, jsp.NextInto(dec, &dst))
+	tassert.Fatalf(t, len(dst) == 1 && dst[0].Name == "c" && dst[0].Opt == "", "unexpected %+v", dst)
+	tassert.Errorf(t, jsp.NextInto(dec, &dst) == io.EOF, "expected EOF")
+}
+
+// compare allocations: fresh slice per decode vs. reused (DecodeInto)
+func BenchmarkDecodeInto(b *testing.B) {
+	opts := jsp.CCSign(1)
+	network := makeIntoPayload(b, 1000, "", opts)
+	b.Run("fresh", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var dst []intoElem
+			_, err := jsp.Decode(bytes.NewReader(network), &dst, opts, "benchmark")
+			tassert.CheckFatal(b, err)
+		}
+	})
+	b.Run("reuse", func(b *testing.B) {
+		var dst []intoElem
+		b.ReportAllocs()
+		for b.Loop() {
+			_, err := jsp.DecodeInto(bytes.NewReader(network), &dst, opts, "benchmark")
+			tassert.CheckFatal(b, err)
+		}
+	})
+}
+
+func TestMaxJSONDepth(t *testing.T) {
+	var errD *jsp.ErrMaxDepth
+
+	// pathological: a megabyte of '['
+	t.Run("hostile", func(t *testing.T) {
+		var v any
+		_, err := jsp.Decode(strings.NewReader(strings.Repeat("[", cos.MiB)), &v, jsp.Plain(), "hostile")
+		tassert.Fatalf(t, errors.As(err, &errD), "expecting max-depth error, got %v", err)
+
+		err = jsp.DecodeFields(strings.NewReader(`{"a":`+strings.Repeat(`{"b":`, cos.MiB)), jsp.Plain(), "hostile",
+			map[string]any{"a": &v})
+		tassert.Fatalf(t, errors.As(err, &errD), "expecting max-depth error, got %v", err)
+	})
+
+	// nest 2x the default
+	deep := func(n int) any {
+		var v any = "[{\"\\\"x\"}]" // brackets and escaped quotes inside strings don't count
+		for range n {
+			v = []any{v}
+		}
+		return v
+	}
+	for _, opts := range []jsp.Options{
+		jsp.Plain(),
+		jsp.CksumSign(1),
+		jsp.CCSign(1),
+		{Signature: true, Metaver: 1, BlockCksum: true, Compress: true},
+		{MaxDecodedSize: cos.MiB},
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				v any
+				b = memsys.PageMM().NewSGL(cos.KiB)
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, jsp.Encode(b, deep(2*jsp.DefaultMaxJSONDepth), opts))
+			data := b.ReadAll()
+
+			_, err := jsp.Decode(bytes.NewReader(data), &v, opts, "deep")
+			tassert.Fatalf(t, errors.As(err, &errD), "expecting max-depth error, got %v", err)
+
+			opts.MaxJSONDepth = 2 * jsp.DefaultMaxJSONDepth
+			_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "deep")
+			tassert.CheckFatal(t, err)
+
+			opts.MaxJSONDepth = -1
+			_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "deep")
+			tassert.CheckFatal(t, err)
+
+			opts.MaxJSONDepth = 2*jsp.DefaultMaxJSONDepth - 1
+			_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "deep")
+			tassert.Fatalf(t, errors.As(err, &errD), "expecting max-depth error, got %v", err)
+		})
+	}
+}
+
+func TestParity(t *testing.T) {
+	for _, opts := range []jsp.Options{
+		{Signature: true, Metaver: 1, Checksum: true, Parity: true},
+		{Signature: true, Metaver: 1, Checksum: true, Compress: true, Parity: true},
+		{Signature: true, Metaver: 1, BlockCksum: true, Parity: true},
+		{Signature: true, Metaver: 1, Compress: true, Parity: true},
+	} {
+		t.Run(opts.String(), func(t *testing.T) {
+			var (
+				lst = makeLsoRes(4000) // many shards
+				b   = memsys.PageMM().NewSGL(cos.MiB)
+				l   = jsp.LayoutOf(opts)
+			)
+			defer b.Free()
+			tassert.CheckFatal(t, jsp.Encode(b, lst, opts))
+			data := b.ReadAll()
+
+			h, err := jsp.Sniff(bytes.NewReader(data), "parity")
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, h.Opts.Parity, "expecting parity flag in the prefix (%s)", h)
+
+			size := int(binary.BigEndian.Uint64(data[l.ParityOff:]))
+			tassert.Fatalf(t, size > 2*cos.KiB && l.ParityOff+l.ParityLen+size < len(data), "invalid payload size %d", size)
+			start := l.ParityOff + l.ParityLen
+
+			// bit rot in the first and the last shards
+			corrupted := bytes.Clone(data)
+			corrupted[start+1] ^= 0x10
+			corrupted[start+size-2] ^= 0x01
+			var out cmn.LsoRes
+			_, err = jsp.Decode(bytes.NewReader(corrupted), &out, opts, "parity")
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, reflect.DeepEqual(lst, &out), "decoded mismatch")
+
+			// ditto, via NewReader
+			jr, err := jsp.NewReader(io.NopCloser(bytes.NewReader(corrupted)), opts, "parity")
+			tassert.CheckFatal(t, err)
+			_, err = io.Copy(io.Discard, jr)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, jr.Close())
+
+			// beyond repair: every shard
+			for off := start; off < start+size; off += cos.KiB / 2 {
+				corrupted[off] ^= 0xff
+			}
+			_, err = jsp.Decode(bytes.NewReader(corrupted), &out, opts, "parity")
+			tassert.Fatalf(t, err != nil, "expecting decode to fail")
+		})
+	}
+
+	// small (single data shard)
+	var (
+		s    = makeStaticStruct()
+		opts = jsp.CCSign(1)
+		b    = memsys.PageMM().NewSGL(cos.KiB)
+		v    testStruct
+	)
+	defer b.Free()
+	opts.Parity = true
+	tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+	data := b.ReadAll()
+	data[jsp.LayoutOf(opts).PayloadOff+3] ^= 0x80
+	_, err := jsp.Decode(bytes.NewReader(data), &v, opts, "parity")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, reflect.DeepEqual(s, v), "structs are not equal: (got: %+v, expected: %+v)", v, s)
+
+	// without parity, the same single flip fails the checksum
+	b.Reset()
+	opts.Parity = false
+	tassert.CheckFatal(t, jsp.Encode(b, s, opts))
+	data = b.ReadAll()
+	data[jsp.LayoutOf(opts).PayloadOff+3] ^= 0x80
+	_, err = jsp.Decode(bytes.NewReader(data), &v, opts, "parity")
+	tassert.Fatalf(t, err != nil, "expecting decode to fail")
+
+	// requires signature
+	err = jsp.Encode(b, s, jsp.Options{Checksum: true, Parity: true})
+	tassert.Fatalf(t, err != nil, "expecting parity without signature to fail")
+}
+
+func TestSelfTest(t *testing.T) {
+	tassert.CheckFatal(t, jsp.SelfTest())
+}
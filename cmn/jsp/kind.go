@@ -0,0 +1,125 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Options.Kind: optional 1-byte content type stored in the top byte of the prefix flags,
+// so that tools (e.g. xmeta -i) can name a blob without knowing what it is upfront
+// - zero: not recorded (also: everything encoded prior to this option)
+// - readers that predate it ignore the unknown flag bits
+// - unregistered kinds render numerically ("kind-N")
+
+const kindShift = 24
+
+// cluster-level metadata
+const (
+	KindNone uint8 = iota
+	KindSmap
+	KindBMD
+	KindRMD
+	KindConfig
+	KindVMD
+	KindEtlMD
+
+	// first kind available to RegisterKind
+	KindUser uint8 = 128
+)
+
+var (
+	kinds = map[uint8]string{
+		KindSmap:   "cluster-map (Smap)",
+		KindBMD:    "bucket-metadata (BMD)",
+		KindRMD:    "rebalance-metadata (RMD)",
+		KindConfig: "cluster-config",
+		KindVMD:    "volume-metadata (VMD)",
+		KindEtlMD:  "etl-metadata (EtlMD)",
+	}
+	kmu sync.RWMutex
+)
+
+func RegisterKind(kind uint8, name string) {
+	debug.Assert(kind != KindNone && name != "")
+	kmu.Lock()
+	if prev, ok := kinds[kind]; ok {
+		kmu.Unlock()
+		debug.Assertf(false, "duplicate jsp kind %d: %q vs %q", kind, prev, name)
+		return
+	}
+	kinds[kind] = name
+	kmu.Unlock()
+}
+
+func KindName(kind uint8) string {
+	kmu.RLock()
+	name, ok := kinds[kind]
+	kmu.RUnlock()
+	if ok {
+		return name
+	}
+	return "kind-" + strconv.Itoa(int(kind))
+}
+
+// Header: signature prefix as is (see Sniff)
+type Header struct {
+	Opts    Options // stored flags, including Kind
+	Metaver uint32  // stored meta version (Opts.Metaver is not validated)
+	JspVer  byte
+}
+
+// read and parse the signature prefix - any meta version, any kind
+func Sniff(r io.Reader, tag string) (*Header, error) {
+	var prefix [prefLen]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, err
+	}
+	l := len(signature)
+	if signature != string(prefix[:l]) {
+		return nil, &ErrBadSignature{tag, string(prefix[:l]), signature}
+	}
+	h := &Header{JspVer: prefix[l], Metaver: binary.BigEndian.Uint32(prefix[cos.SizeofI64:])}
+	h.Opts.Signature = true
+	h.Opts.Metaver = h.Metaver
+	h.Opts.setFlags(binary.BigEndian.Uint32(prefix[cos.SizeofI64+cos.SizeofI32:]))
+	return h, nil
+}
+
+// e.g. "bucket-metadata (BMD) v5 (jsp v3, cksum, lz4)"
+func (h *Header) String() string {
+	var sb strings.Builder
+	if h.Opts.Kind != KindNone {
+		sb.WriteString(KindName(h.Opts.Kind))
+	} else {
+		sb.WriteString("unknown kind")
+	}
+	fmt.Fprintf(&sb, " v%d (jsp v%d", h.Metaver, h.JspVer)
+	if h.Opts.BlockCksum {
+		sb.WriteString(", blk-cksum")
+	} else if h.Opts.Checksum {
+		sb.WriteString(", cksum")
+	}
+	if h.Opts.Compress {
+		sb.WriteString(", lz4")
+	}
+	if h.Opts.Parity {
+		sb.WriteString(", parity")
+	}
+	if h.Opts.Format == FmtMsgPack {
+		sb.WriteString(", msgpack")
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
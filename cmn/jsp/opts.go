@@ -5,6 +5,17 @@
  */
 package jsp
 
+import (
+	"strconv"
+	"strings"
+)
+
+// serialization formats
+const (
+	FmtJSON    = iota // default
+	FmtMsgPack        // requires msgp.Encodable (Encode) and msgp.Decodable (Decode), e.g. msgp-generated
+)
+
 type (
 	Options struct {
 		// when non-zero, formatting version of the structure that's being (de)serialized
@@ -12,18 +23,69 @@ type (
 		Metaver uint32
 		// warn and keep loading
 		OldMetaverOk uint32
+		// when non-nil, called instead of the warning (above) - to log, migrate, or reject
+		// (non-nil error fails decoding); incompatible versions fail regardless
+		OnVersionSkew func(tag string, got, want uint32) error
 
 		Compress  bool // lz4 when [version == 1 || version == 2]
 		Checksum  bool // xxhash when [version == 1 || version == 2]
 		Signature bool // when true, write 128bit prefix (of the layout shown above) at offset zero
 
+		// (requires Signature; supersedes Checksum) checksum every 64KiB block of the payload
+		// to fail early upon corruption, without parsing the rest (see blk.go)
+		BlockCksum bool
+
+		// (requires Signature) append Reed-Solomon parity to repair small corruptions
+		// (e.g., bit rot) upon Decode - before checksum verification (see parity.go)
+		Parity bool
+
 		Indent bool // Determines if the JSON should be indented. Useful for CLI config.
+
+		// when non-empty (and Indent, no compression, no checksum), a human-readable note
+		// (e.g., app version and tag) written ahead of the JSON, along with timestamp (see annotate.go)
+		Annotate string
+
+		// when non-zero, max size of the decoded (decompressed) payload;
+		// exceeding it fails Decode with ErrSizeLimit (e.g., decompression bomb)
+		MaxDecodedSize int64
+
+		// max nesting depth of JSON objects and arrays (zero: DefaultMaxJSONDepth, negative: unlimited);
+		// exceeding it fails Decode with ErrMaxDepth (see depth.go)
+		MaxJSONDepth int
+
+		// serialization format: FmtJSON (default) or FmtMsgPack;
+		// with signature, Decode self-selects (see flagMsgPack)
+		Format uint8
+
+		// byte-stable output: same value => same bytes (see det.go)
+		Deterministic bool
+
+		// (requires Compress) record the uncompressed length in the lz4 frame header (see rawlen.go)
+		StoreRawLen bool
+
+		// (requires Signature) content type of the encoded structure, e.g. KindBMD (see kind.go)
+		Kind uint8
+
+		// (decode) the signature prefix says the payload starts with the annotation line
+		hasMeta bool
 	}
 	Opts interface {
 		JspOpts() Options
 	}
 )
 
+// Clone returns an independent copy; when adding reference-type fields
+// (pointers, slices, maps) make sure to deep-copy them here (funcs are shared).
+func (opts *Options) Clone() Options { return *opts }
+
+// plain JSON: no prefix, no checksum, no compression (see Encode and Decode fast path)
+func (opts *Options) plain() bool {
+	return !opts.Signature && !opts.Checksum && !opts.Compress && !opts.BlockCksum && opts.Format == FmtJSON
+}
+
+// Reset zeroes all options (e.g., to reuse a pooled or long-lived instance).
+func (opts *Options) Reset() { *opts = Options{} }
+
 func Plain() Options { return Options{} }
 
 func CCSign(metaver uint32) Options {
@@ -33,3 +95,59 @@ func CCSign(metaver uint32) Options {
 func CksumSign(metaver uint32) Options {
 	return Options{Metaver: metaver, Checksum: true, Signature: true}
 }
+
+// String renders active toggles, e.g. "jsp[sign,cksum,lz4,v2]" (for logging)
+func (opts *Options) String() string {
+	var sb strings.Builder
+	sb.WriteString("jsp[")
+	n := sb.Len()
+	add := func(s string) {
+		if sb.Len() > n {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(s)
+	}
+	if opts.Signature {
+		add("sign")
+	}
+	if opts.BlockCksum {
+		add("blk-cksum")
+	} else if opts.Checksum {
+		add("cksum")
+	}
+	if opts.Parity {
+		add("parity")
+	}
+	if opts.Compress {
+		add("lz4")
+		if opts.StoreRawLen {
+			add("raw-len")
+		}
+	}
+	if opts.Format == FmtMsgPack {
+		add("msgpack")
+	}
+	if opts.Indent {
+		add("indent")
+	}
+	if opts.annotated() {
+		add("annotated")
+	}
+	if opts.Deterministic {
+		add("det")
+	}
+	if opts.Kind != 0 {
+		add(KindName(opts.Kind))
+	}
+	if opts.Metaver != 0 {
+		add("v" + strconv.FormatUint(uint64(opts.Metaver), 10))
+	}
+	if opts.OldMetaverOk != 0 {
+		add("old-ok:v" + strconv.FormatUint(uint64(opts.OldMetaverOk), 10))
+	}
+	if sb.Len() == n {
+		sb.WriteString("plain")
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
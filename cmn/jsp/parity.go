@@ -0,0 +1,172 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	"github.com/klauspost/reedsolomon"
+)
+
+// Options.Parity: Reed-Solomon parity, to survive bit rot on unreliable media
+// - the payload (everything that follows the signature prefix) is split into (up to
+//   parityMaxData) equal data shards, each (at least) parityMinShard in size
+// - parity shards: max(parityMinPar, data/8), whereby each shard (data and parity) gets
+//   its own xxhash64 to tell corrupted shards from good ones
+// - Decode verifies the shards and repairs up to that many corrupted ones - all
+//   _before_ the regular (checksum, lz4, JSON) decoding
+// - the prefix itself is not covered: signature, versions, and flags must be intact
+//
+// layout: [ prefix | parity header | payload | parity shards | shard hashes ]
+// parity header: [ payload length (8) | shard size (4) | data (2) | parity (2) | xxhash64 of the above (8) ]
+
+const (
+	parityHdrLen   = 24
+	parityMinShard = cos.KiB
+	parityMaxData  = 64
+	parityMinPar   = 2
+	parityMaxLen   = cos.GiB // sanity
+)
+
+var errParitySign = errors.New("jsp: parity requires signature")
+
+type parityBuf struct {
+	b []byte
+}
+
+func (pb *parityBuf) Write(p []byte) (int, error) {
+	pb.b = append(pb.b, p...)
+	return len(p), nil
+}
+
+func (pb *parityBuf) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 || int(off)+len(p) > len(pb.b) {
+		return 0, fmt.Errorf("jsp: write-at offset %d out of range [0, %d)", off, len(pb.b))
+	}
+	return copy(pb.b[off:], p), nil
+}
+
+func paritySizes(n int) (ndata, npar, shardSize int) {
+	ndata = min(max((n+parityMinShard-1)/parityMinShard, 1), parityMaxData)
+	npar = max(parityMinPar, ndata/8)
+	shardSize = max((n+ndata-1)/ndata, 1)
+	return ndata, npar, shardSize
+}
+
+// encode as usual (into memory), and then append parity
+func encodeParity(ws cos.WriterAt, v any, opts Options) error {
+	var pb parityBuf
+	opts.Parity = false
+	if err := Encode(&pb, v, opts); err != nil {
+		return err
+	}
+	prefix, payload := pb.b[:prefLen], pb.b[prefLen:]
+	flags := binary.BigEndian.Uint32(prefix[cos.SizeofI64+cos.SizeofI32:])
+	binary.BigEndian.PutUint32(prefix[cos.SizeofI64+cos.SizeofI32:], flags|flagParity)
+	prefix[len(signature)] = MetaverExt
+
+	ndata, npar, shardSize := paritySizes(len(payload))
+	enc, err := reedsolomon.New(ndata, npar)
+	if err != nil {
+		return err
+	}
+	var (
+		all    = make([]byte, (ndata+npar)*shardSize)
+		shards = make([][]byte, ndata+npar)
+		hashes = make([]byte, (ndata+npar)*cos.SizeXXHash64)
+		hdr    [parityHdrLen]byte
+	)
+	copy(all, payload)
+	for i := range shards {
+		shards[i] = all[i*shardSize : (i+1)*shardSize]
+	}
+	if err := enc.Encode(shards); err != nil {
+		return err
+	}
+	for i := range shards {
+		binary.BigEndian.PutUint64(hashes[i*cos.SizeXXHash64:], onexxh.Checksum64(shards[i]))
+	}
+	binary.BigEndian.PutUint64(hdr[0:], uint64(len(payload)))
+	binary.BigEndian.PutUint32(hdr[8:], uint32(shardSize))
+	binary.BigEndian.PutUint16(hdr[12:], uint16(ndata))
+	binary.BigEndian.PutUint16(hdr[14:], uint16(npar))
+	binary.BigEndian.PutUint64(hdr[16:], onexxh.Checksum64(hdr[:16]))
+
+	for _, b := range [][]byte{prefix, hdr[:], payload, all[ndata*shardSize:], hashes} {
+		if _, err := ws.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read the payload along with its parity; verify, and repair if need be
+func readParity(r io.Reader, tag string) (io.Reader, error) {
+	var hdr [parityHdrLen]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	if expected, actual := binary.BigEndian.Uint64(hdr[16:]), onexxh.Checksum64(hdr[:16]); expected != actual {
+		return nil, cos.NewErrMetaCksum(expected, actual, tag+" (parity header)")
+	}
+	var (
+		size      = int64(binary.BigEndian.Uint64(hdr[0:]))
+		shardSize = int(binary.BigEndian.Uint32(hdr[8:]))
+		ndata     = int(binary.BigEndian.Uint16(hdr[12:]))
+		npar      = int(binary.BigEndian.Uint16(hdr[14:]))
+	)
+	if size <= 0 || size > parityMaxLen {
+		return nil, fmt.Errorf("jsp: %s: invalid parity header [size %d]", tag, size)
+	}
+	if d, p, s := paritySizes(int(size)); d != ndata || p != npar || s != shardSize {
+		return nil, fmt.Errorf("jsp: %s: invalid parity header [size %d, shard %d, data %d, parity %d]",
+			tag, size, shardSize, ndata, npar)
+	}
+	var (
+		all    = make([]byte, (ndata+npar)*shardSize)
+		hashes = make([]byte, (ndata+npar)*cos.SizeXXHash64)
+		shards = make([][]byte, ndata+npar)
+	)
+	if _, err := io.ReadFull(r, all[:size]); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, all[ndata*shardSize:]); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, hashes); err != nil {
+		return nil, err
+	}
+	var bad int
+	for i := range shards {
+		shards[i] = all[i*shardSize : (i+1)*shardSize]
+		if onexxh.Checksum64(shards[i]) != binary.BigEndian.Uint64(hashes[i*cos.SizeXXHash64:]) {
+			shards[i] = shards[i][:0] // reconstruct in place
+			bad++
+		}
+	}
+	if bad > 0 {
+		if bad > npar {
+			return nil, fmt.Errorf("jsp: %s: %d corrupted shards (of %d) exceed parity (%d)", tag, bad, ndata+npar, npar)
+		}
+		enc, err := reedsolomon.New(ndata, npar)
+		if err != nil {
+			return nil, err
+		}
+		if err := enc.ReconstructData(shards); err != nil {
+			return nil, fmt.Errorf("jsp: %s: failed to repair: %w", tag, err)
+		}
+		nlog.Warningf("jsp: %s: repaired %d corrupted shard(s) of %d bytes", tag, bad, shardSize)
+	}
+	return bytes.NewReader(all[:size]), nil
+}
@@ -0,0 +1,59 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"io"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// Encoding hot path (metadata saves): reuse encoders instead of allocating per call
+// - JSON: jsoniter streams, pooled per frozen config (see cos.JSON.BorrowStream);
+//   indented JSON (CLI config and such) is rare and remains unpooled
+// - lz4: writers with the block size preset; Reset rebinds the destination
+
+var zwPool sync.Pool
+
+func allocZW(w io.Writer) *lz4.Writer {
+	if v := zwPool.Get(); v != nil {
+		zw := v.(*lz4.Writer)
+		zw.Reset(w)
+		return zw
+	}
+	zw := lz4.NewWriter(w)
+	err := zw.Apply(lz4.BlockSizeOption(lz4BufferSize))
+	debug.AssertNoErr(err)
+	return zw
+}
+
+// (must be closed)
+func freeZW(zw *lz4.Writer) {
+	zw.Reset(nil)
+	err := zw.Apply(lz4.SizeOption(0)) // clear content size, if set (see encodeRawLen)
+	debug.AssertNoErr(err)
+	zwPool.Put(zw)
+}
+
+// same as jsoniter Encoder.Encode (including trailing newline)
+func encodeJSON(w io.Writer, v any, opts *Options) error {
+	api := opts.jsonAPI()
+	if opts.Indent {
+		encoder := api.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+	stream := api.BorrowStream(w)
+	stream.WriteVal(v)
+	stream.WriteRaw("\n")
+	stream.Flush()
+	err := stream.Error
+	api.ReturnStream(stream)
+	return err
+}
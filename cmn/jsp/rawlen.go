@@ -0,0 +1,104 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Options.StoreRawLen: record the uncompressed payload length - in the lz4 frame
+// descriptor (the standard optional "content size" field), so that:
+// - readers predating this option (and lz4 tools in general) ignore it
+// - DecodeInfo reports it (e.g., to pre-allocate or show progress)
+// - Decode with MaxDecodedSize fails early, before decompressing anything
+// The cost: the encoded payload gets buffered (to know its length upfront).
+
+type Info struct {
+	Opts   Options // stored flags (with signature) or the caller's options otherwise
+	RawLen int64   // uncompressed payload length, or -1 when not recorded
+}
+
+func (opts *Options) rawLen() bool { return opts.StoreRawLen && opts.Compress }
+
+// encode into memory, set the frame's content size, and write through
+func encodeRawLen(w io.Writer, zw *lz4.Writer, v any, opts *Options) error {
+	var (
+		raw bytes.Buffer
+		err error
+	)
+	if opts.Format == FmtMsgPack {
+		err = encodeMsgp(&raw, v)
+	} else {
+		err = encodeJSON(&raw, v, opts)
+	}
+	if err != nil {
+		return err
+	}
+	if err := zw.Apply(lz4.SizeOption(uint64(raw.Len()))); err != nil {
+		return err
+	}
+	_, err = w.Write(raw.Bytes())
+	return err
+}
+
+// with limit: fail early when the recorded length exceeds it
+func newZR(r io.Reader, lr *limReader) (io.Reader, error) {
+	zr := lz4.NewReader(r)
+	if lr == nil {
+		return zr, nil
+	}
+	if _, err := zr.Read(nil); err != nil { // (reads frame descriptor)
+		return nil, err
+	}
+	if n := int64(zr.Size()); n > lr.limit {
+		lr.err = &ErrSizeLimit{lr.limit}
+		return nil, lr.err
+	}
+	return zr, nil
+}
+
+// DecodeInfo reads (only) the header(s) and returns what's known about the payload
+// without decoding it; as with Decode, the stored flags take precedence.
+func DecodeInfo(r io.Reader, opts Options, tag string) (*Info, error) {
+	info := &Info{RawLen: -1}
+	if opts.Signature {
+		if err := readPrefix(r, &opts, tag, nil); err != nil {
+			return nil, err
+		}
+	}
+	info.Opts = opts
+	if !opts.Compress {
+		return info, nil
+	}
+	switch {
+	case opts.BlockCksum:
+		var total [cos.SizeofI64]byte
+		if _, err := io.ReadFull(r, total[:]); err != nil {
+			return nil, err
+		}
+		r = &blkReader{r: r, h: onexxh.New64(), tag: tag, remain: int64(binary.BigEndian.Uint64(total[:]))}
+	case opts.Checksum:
+		var cksum [cos.SizeXXHash64]byte
+		if _, err := io.ReadFull(r, cksum[:]); err != nil {
+			return nil, err
+		}
+	}
+	zr := lz4.NewReader(r)
+	if _, err := zr.Read(nil); err != nil {
+		return nil, err
+	}
+	if n := zr.Size(); n > 0 {
+		info.RawLen = int64(n)
+	}
+	return info, nil
+}
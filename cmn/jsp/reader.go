@@ -0,0 +1,154 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pierrec/lz4/v4"
+)
+
+const fieldsBufSize = 4 * cos.KiB
+
+// decoding reader: yields the payload as is (JSON or msgpack bytes)
+type reader struct {
+	r        io.Reader
+	rc       io.Closer
+	h        hash.Hash64 // Options.Checksum
+	br       *blkReader  // Options.BlockCksum
+	tag      string
+	expected uint64
+	eof      bool
+}
+
+// interface guard
+var _ io.ReadCloser = (*reader)(nil)
+
+// NewReader strips the signature, sets up decompression and checksumming, and returns
+// a reader of the raw encoded bytes (no decoding into a structure);
+// the checksum, if any, is validated on Close (reading the remaining payload, if need be).
+// The caller's options are not modified - the stored flags (signature) take precedence.
+func NewReader(r io.ReadCloser, opts Options, tag string) (io.ReadCloser, error) {
+	jr, _, err := newReader(r, opts, tag)
+	if err != nil {
+		return nil, err
+	}
+	return jr, nil
+}
+
+// DecodeFields streams a top-level JSON object and decodes only the `want`ed keys,
+// each into its respective pointer (e.g., want["version"] = &ver); all other keys
+// are skipped without materializing. The checksum, if any, is validated over the
+// entire payload. JSON only.
+func DecodeFields(r io.Reader, opts Options, tag string, want map[string]any) error {
+	jr, opts, err := newReader(io.NopCloser(r), opts, tag)
+	if err != nil {
+		return err
+	}
+	if opts.Format != FmtJSON {
+		jr.Close()
+		return fmt.Errorf("jsp: %s: DecodeFields requires JSON format (got %d)", tag, opts.Format)
+	}
+	dr := newDepthReader(&opts)
+	it := jsoniter.Parse(cos.JSON, dr.wrap(jr), fieldsBufSize)
+	it.ReadObjectCB(func(it *jsoniter.Iterator, key string) bool {
+		if ptr, ok := want[key]; ok {
+			it.ReadVal(ptr)
+		} else {
+			it.Skip()
+		}
+		return it.Error == nil
+	})
+	err = it.Error
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if errC := jr.Close(); errC != nil {
+		return errC // (checksum) takes precedence
+	}
+	return dr.check(err)
+}
+
+func newReader(r io.ReadCloser, opts Options, tag string) (*reader, Options, error) {
+	opts = opts.Clone()
+	if opts.Signature {
+		if err := readPrefix(r, &opts, tag, nil); err != nil {
+			return nil, opts, err
+		}
+	}
+	var (
+		jr = &reader{rc: r, tag: tag}
+		rr io.Reader
+	)
+	if opts.Parity {
+		pr, err := readParity(r, tag)
+		if err != nil {
+			return nil, opts, err
+		}
+		r = io.NopCloser(pr) // (jr.rc closes the original)
+	}
+	switch {
+	case opts.BlockCksum:
+		var total [cos.SizeofI64]byte
+		if _, err := io.ReadFull(r, total[:]); err != nil {
+			return nil, opts, err
+		}
+		jr.br = &blkReader{r: r, h: onexxh.New64(), tag: tag, remain: int64(binary.BigEndian.Uint64(total[:]))}
+		rr = jr.br
+	case opts.Checksum:
+		var cksum [cos.SizeXXHash64]byte
+		if _, err := io.ReadFull(r, cksum[:]); err != nil {
+			return nil, opts, err
+		}
+		jr.expected = binary.BigEndian.Uint64(cksum[:])
+		rr = r
+	default:
+		rr = r
+	}
+	if opts.Compress {
+		rr = lz4.NewReader(rr)
+	}
+	if opts.MaxDecodedSize > 0 {
+		rr = &limReader{r: rr, limit: opts.MaxDecodedSize, remain: opts.MaxDecodedSize}
+	}
+	if opts.Checksum && !opts.BlockCksum {
+		jr.h = onexxh.New64()
+		rr = io.TeeReader(rr, jr.h)
+	}
+	jr.r = rr
+	return jr, opts, nil
+}
+
+func (jr *reader) Read(p []byte) (n int, err error) {
+	n, err = jr.r.Read(p)
+	jr.eof = jr.eof || err == io.EOF
+	return n, err
+}
+
+func (jr *reader) Close() (err error) {
+	if jr.h != nil || jr.br != nil {
+		// validate the entire payload
+		if !jr.eof {
+			_, err = io.Copy(io.Discard, jr)
+		}
+		if err == nil && jr.h != nil {
+			if actual := jr.h.Sum64(); actual != jr.expected {
+				err = cos.NewErrMetaCksum(jr.expected, actual, jr.tag)
+			}
+		}
+	}
+	if errC := jr.rc.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
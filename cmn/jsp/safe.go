@@ -0,0 +1,119 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Atomic replacement of persisted metadata:
+// - EncodeFileAtomic encodes into a temp file in the same directory and renames it
+//   over the target - readers see either the old or the new content, never a torn write
+// - prior to the rename, the current content (if any) is hard-linked as <path>.bak
+// - with feat.FsyncMeta: fsync the temp file before the rename, and the directory after
+// - DecodeFileSafe reads <path>, falling back to <path>.bak on checksum failure
+//   (unlike Load, does not remove the corrupted file - the next EncodeFileAtomic replaces it)
+
+const BakSuffix = ".bak"
+
+// feat.FsyncMeta (set via cmn.Rom)
+var fsyncMeta atomic.Bool
+
+func SetFsyncMeta(v bool) { fsyncMeta.Store(v) }
+
+func EncodeFileAtomic(fpath string, v any, opts Options) (err error) {
+	var (
+		file *os.File
+		tmp  = fpath + ".tmp." + cos.GenTie()
+	)
+	if file, err = cos.CreateFile(tmp); err != nil {
+		return err
+	}
+	if err = Encode(file, v, opts); err == nil && fsyncMeta.Load() {
+		err = file.Sync()
+	}
+	if errC := file.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = _bak(fpath)
+	}
+	if err == nil {
+		err = os.Rename(tmp, fpath)
+	}
+	if err != nil {
+		if nestedErr := cos.RemoveFile(tmp); nestedErr != nil {
+			nlog.Errorf("Nested (%v): failed to remove %s, err: %v", err, tmp, nestedErr)
+		}
+		return err
+	}
+	if fsyncMeta.Load() {
+		err = _fsyncDir(filepath.Dir(fpath))
+	}
+	return err
+}
+
+// (hard link: no copying; the rename that follows leaves .bak with the previous content)
+func _bak(fpath string) error {
+	bak := fpath + BakSuffix
+	if err := cos.RemoveFile(bak); err != nil {
+		return err
+	}
+	if err := os.Link(fpath, bak); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func _fsyncDir(dir string) error {
+	fh, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = fh.Sync()
+	cos.Close(fh)
+	return err
+}
+
+func DecodeFileSafe(fpath string, v any, opts Options) (*cos.Cksum, error) {
+	cksum, err := _decodeFile(fpath, v, opts)
+	if err == nil {
+		return cksum, nil
+	}
+	var errC *cos.ErrBadCksum
+	if !errors.As(err, &errC) {
+		return nil, err
+	}
+	errC.Source = fpath
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv.Elem().SetZero() // (partially decoded)
+	}
+	bak := fpath + BakSuffix
+	cksum, errB := _decodeFile(bak, v, opts)
+	if errB != nil {
+		nlog.Errorln("jsp:", err, "- failed to fall back to", bak+":", errB)
+		return nil, err
+	}
+	nlog.Warningln("jsp:", err, "- loaded", bak)
+	return cksum, nil
+}
+
+func _decodeFile(fpath string, v any, opts Options) (*cos.Cksum, error) {
+	fh, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	cksum, err := Decode(fh, v, opts, _tag(fpath, v))
+	cos.Close(fh)
+	return cksum, err
+}
@@ -0,0 +1,130 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// SelfTest round-trips a fixed fixture through every supported combination of
+// (JSON) options, to catch format regressions early (e.g., flag bits getting shifted):
+// - decoded value must be equal to the encoded one
+// - checksummed (and not parity-protected) payloads must fail to decode upon corruption
+// - parity-protected payloads must survive the same corruption
+// Not in the hot path: called by unit tests and, in debug builds, once upon startup.
+
+type stFixture struct {
+	Tags  map[string]string `json:"tags"`
+	Inner *stFixture        `json:"inner,omitempty"`
+	Name  string            `json:"name"`
+	Vals  []int64           `json:"vals"`
+	Blob  []byte            `json:"blob"` // spans several blocks (see blkSize) and parity shards
+	Flag  bool              `json:"flag"`
+}
+
+func newFixture() *stFixture {
+	var (
+		x   = uint64(0x9e3779b97f4a7c15)
+		fix = &stFixture{
+			Name:  "jsp self-test",
+			Tags:  map[string]string{"a": "1", "b": "\"quoted\"", "c": "{[nested]}"},
+			Vals:  make([]int64, 1000),
+			Blob:  make([]byte, 96*1024),
+			Flag:  true,
+			Inner: &stFixture{Name: "inner", Vals: []int64{-1, 0, 1}},
+		}
+	)
+	for i := range fix.Vals {
+		fix.Vals[i] = int64(i * i)
+	}
+	for i := range fix.Blob { // xorshift: not too compressible
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		fix.Blob[i] = byte(x)
+	}
+	return fix
+}
+
+// all valid combinations
+func selfTestOpts() (all []Options) {
+	const nbits = 8
+	for mask := range 1 << nbits {
+		bit := func(i int) bool { return mask&(1<<i) != 0 }
+		opts := Options{
+			Signature:     bit(0),
+			Checksum:      bit(1),
+			Compress:      bit(2),
+			BlockCksum:    bit(3),
+			Parity:        bit(4),
+			StoreRawLen:   bit(5),
+			Indent:        bit(6),
+			Deterministic: bit(7),
+		}
+		if (opts.BlockCksum || opts.Parity) && !opts.Signature {
+			continue
+		}
+		if opts.StoreRawLen && !opts.Compress {
+			continue
+		}
+		if opts.Signature {
+			opts.Metaver, opts.Kind = 1, KindConfig
+		}
+		all = append(all, opts)
+	}
+	return append(all, Options{Indent: true, Annotate: "self-test"})
+}
+
+func SelfTest() error {
+	fix := newFixture()
+	for _, opts := range selfTestOpts() {
+		if err := selfTest(fix, opts); err != nil {
+			return fmt.Errorf("jsp self-test %s: %w", opts.String(), err)
+		}
+	}
+	return nil
+}
+
+func selfTest(fix *stFixture, opts Options) error {
+	var (
+		pb  parityBuf
+		tag = "self-test"
+	)
+	if err := Encode(&pb, fix, opts); err != nil {
+		return err
+	}
+	out := &stFixture{}
+	cksum, err := Decode(bytes.NewReader(pb.b), out, opts, tag)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(fix, out) {
+		return fmt.Errorf("decoded value differs (%d bytes)", len(pb.b))
+	}
+	if (opts.Checksum || opts.BlockCksum) && cksum == nil {
+		return fmt.Errorf("expecting checksum (%d bytes)", len(pb.b))
+	}
+
+	// corrupt a single byte past the prefix
+	if !opts.Checksum && !opts.BlockCksum && !opts.Parity {
+		return nil
+	}
+	off := len(pb.b) / 2
+	if opts.Signature {
+		off = prefLen + (len(pb.b)-prefLen)/2
+	}
+	pb.b[off] ^= 0xff
+	_, err = Decode(bytes.NewReader(pb.b), &stFixture{}, opts, tag)
+	switch {
+	case opts.Parity && err != nil:
+		return fmt.Errorf("failed to repair corrupted byte at offset %d: %w", off, err)
+	case !opts.Parity && err == nil:
+		return fmt.Errorf("failed to detect corrupted byte at offset %d (of %d)", off, len(pb.b))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Package jsp (JSON persistence) provides utilities to store and load arbitrary
+// JSON-encoded structures with optional checksumming and compression.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package jsp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Streams of records (e.g., large, incrementally produced metadata logs):
+//   [ 8 bytes: frame length (big-endian) | frame: the record, as written by Encode ] ...
+// - each frame is an independent jsp payload (signature, checksum, compression - per Options)
+// - Encoder reuses its frame buffer (and pooled lz4 writers - see pool.go) across records
+// - DecodeStream iterates the frames; a truncated (partially written) last frame
+//   yields io.ErrUnexpectedEOF
+
+const (
+	frameHdrLen  = cos.SizeofI64
+	maxFrameSize = cos.GiB // sanity
+)
+
+var errEncoderClosed = errors.New("jsp: encoder closed")
+
+type (
+	Encoder struct {
+		w    io.Writer
+		buf  frameBuf
+		opts Options
+		err  error // sticky
+		cnt  int64
+	}
+	StreamDecoder struct {
+		r    io.Reader
+		tag  string
+		buf  []byte
+		opts Options
+		cnt  int64
+	}
+	// in-memory cos.WriterAt (Encode backfills checksum and such)
+	frameBuf struct {
+		b []byte
+	}
+)
+
+/////////////
+// Encoder //
+/////////////
+
+func NewEncoder(w cos.WriterAt, opts Options) *Encoder {
+	e := &Encoder{w: w, opts: opts}
+	e.buf.b = make([]byte, frameHdrLen, 4*cos.KiB)
+	return e
+}
+
+// Write encodes `v` as the next frame
+func (e *Encoder) Write(v any) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.buf.b = e.buf.b[:frameHdrLen]
+	if err := Encode(&e.buf, v, e.opts); err != nil {
+		return err // (nothing written - not sticky)
+	}
+	binary.BigEndian.PutUint64(e.buf.b, uint64(len(e.buf.b)-frameHdrLen))
+	if _, err := e.w.Write(e.buf.b); err != nil {
+		e.err = err
+		return err
+	}
+	e.cnt++
+	return nil
+}
+
+// number of records written so far
+func (e *Encoder) Count() int64 { return e.cnt }
+
+// Close does not close the underlying writer
+func (e *Encoder) Close() error {
+	err := e.err
+	if err == errEncoderClosed {
+		return nil
+	}
+	e.err = errEncoderClosed
+	e.buf.b = nil
+	return err
+}
+
+func (fb *frameBuf) Write(p []byte) (int, error) {
+	fb.b = append(fb.b, p...)
+	return len(p), nil
+}
+
+// (frame-relative offset)
+func (fb *frameBuf) WriteAt(p []byte, off int64) (int, error) {
+	at := int(off) + frameHdrLen
+	if off < 0 || at+len(p) > len(fb.b) {
+		return 0, fmt.Errorf("jsp: write-at offset %d out of range [0, %d)", off, len(fb.b)-frameHdrLen)
+	}
+	return copy(fb.b[at:], p), nil
+}
+
+///////////////////
+// StreamDecoder //
+///////////////////
+
+func DecodeStream(r io.Reader, opts Options, tag string) *StreamDecoder {
+	return &StreamDecoder{r: r, opts: opts, tag: tag}
+}
+
+// Next decodes the next record into `v`; returns io.EOF at the end of the stream
+func (d *StreamDecoder) Next(v any) error {
+	var hdr [frameHdrLen]byte
+	if _, err := io.ReadFull(d.r, hdr[:]); err != nil {
+		return err // io.EOF (clean end) or io.ErrUnexpectedEOF
+	}
+	size := binary.BigEndian.Uint64(hdr[:])
+	if size > maxFrameSize {
+		return fmt.Errorf("jsp: %s: invalid frame #%d size %d", d.tag, d.cnt, size)
+	}
+	if uint64(cap(d.buf)) < size {
+		d.buf = make([]byte, size)
+	}
+	d.buf = d.buf[:size]
+	if _, err := io.ReadFull(d.r, d.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	tag := d.tag + "[" + strconv.FormatInt(d.cnt, 10) + "]"
+	if _, err := Decode(bytes.NewReader(d.buf), v, d.opts, tag); err != nil {
+		return err
+	}
+	d.cnt++
+	return nil
+}
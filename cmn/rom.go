@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 )
 
 // read-mostly and most often used config values: assigned at startup and updated
@@ -43,6 +44,8 @@ func (rom *readMostly) Set(cfg *ClusterConfig) {
 		rom.timeout.ecstreams = d.D()
 	}
 	rom.features = cfg.Features
+	jsp.SetVerbose(cfg.Features.IsSet(feat.VerboseMetaErrors))
+	jsp.SetFsyncMeta(cfg.Features.IsSet(feat.FsyncMeta))
 
 	rom.authEnabled = cfg.Auth.Enabled
 	rom.signVerifyEnabled = cfg.Auth.SignVerifyEnabled()
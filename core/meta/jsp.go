@@ -19,13 +19,19 @@ var (
 // Compress, Checksum, Sign (CCS)
 
 var (
-	bmdJspOpts = jsp.CCSign(cmn.MetaverBMD) // ditto
-	rmdJspOpts = jsp.CCSign(cmn.MetaverRMD) // ditto
+	bmdJspOpts = _kind(jsp.CCSign(cmn.MetaverBMD), jsp.KindBMD) // ditto
+	rmdJspOpts = _kind(jsp.CCSign(cmn.MetaverRMD), jsp.KindRMD) // ditto
 )
 
+func _kind(opts jsp.Options, kind uint8) jsp.Options {
+	opts.Kind = kind
+	return opts
+}
+
 func (*Smap) JspOpts() jsp.Options {
 	opts := jsp.CCSign(cmn.MetaverSmap)
 	opts.OldMetaverOk = 1
+	opts.Kind = jsp.KindSmap
 	return opts
 }
 
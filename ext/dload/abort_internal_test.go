@@ -0,0 +1,68 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAbortMatching(t *testing.T) {
+	testStore(t, map[string]int{"a1": 0, "a2": 0, "b1": 0, "fin": 0, "abrt": 0})
+	js := g.store.dljobs
+	desc := map[string]string{
+		"a1": "imagenet train", "a2": "imagenet val", "b1": "coco", "fin": "imagenet test", "abrt": "imagenet old",
+	}
+	d := &dispatcher{abortJob: make(map[string]*cos.StopCh, len(desc))}
+	for id, s := range desc {
+		js[id].description = s
+		d.abortJob[id] = cos.NewStopCh()
+	}
+	js["fin"].finishedTime.Store(time.Now())
+	js["abrt"].aborted.Store(true)
+
+	req := &request{action: actAbort, regex: regexp.MustCompilePOSIX("^imagenet")}
+	d.handleAbortMatching(req)
+	tassert.Fatalf(t, req.response.err == nil, "unexpected error: %v", req.response.err)
+	resp, ok := req.response.value.(*AbortResp)
+	tassert.Fatalf(t, ok, "unexpected response %T", req.response.value)
+	tassert.Errorf(t, resp.Aborted == 2, "expecting 2 running jobs aborted, got %d", resp.Aborted)
+
+	for id, expected := range map[string]bool{"a1": true, "a2": true, "b1": false, "fin": false} {
+		tassert.Errorf(t, js[id].aborted.Load() == expected, "%s: expecting aborted=%t", id, expected)
+		select {
+		case <-d.abortJob[id].Listen():
+			tassert.Errorf(t, expected, "%s: not expecting abort signal", id)
+		default:
+			tassert.Errorf(t, !expected, "%s: expecting abort signal", id)
+		}
+	}
+
+	// no matches
+	req = &request{action: actAbort, regex: regexp.MustCompilePOSIX("^nothing")}
+	d.handleAbortMatching(req)
+	tassert.Errorf(t, req.response.value.(*AbortResp).Aborted == 0, "expecting none aborted")
+}
+
+func TestAbortValidate(t *testing.T) {
+	tests := []struct {
+		body  AdminBody
+		valid bool
+	}{
+		{AdminBody{Regex: "^imagenet"}, true},
+		{AdminBody{ID: "id"}, true},
+		{AdminBody{ID: "id", Regex: "x"}, false},
+		{AdminBody{Regex: "[a-"}, false},
+		{AdminBody{}, false},
+	}
+	for _, test := range tests {
+		err := test.body.Validate(true)
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, got %v", test.body, test.valid, err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"path"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -27,10 +28,29 @@ const (
 	TypeRange   Type = "range"
 	TypeMulti   Type = "multi"
 	TypeBackend Type = "backend"
+	TypeImport  Type = "import" // from a listing of (link, name) pairs - see import.go
 )
 
 const DownloadProgressInterval = 10 * time.Second
 
+// max long-poll duration of a single status request (see AdminBody.Wait)
+const MaxStatusWait = 10 * time.Minute
+
+// why an item was skipped (see Job.Skipped)
+type SkipReason int
+
+const (
+	SkipUpToDate    SkipReason = iota // already in the cluster and equal to the source (see DiffResolverSkip)
+	SkipNotModified                   // conditional GET: 304 Not Modified
+	SkipResumed                       // finished prior to restart (see resume.go)
+
+	numSkipReasons
+)
+
+var skipReasons = [numSkipReasons]string{"up-to-date", "not-modified", "resumed"}
+
+func (r SkipReason) String() string { return skipReasons[r] }
+
 type (
 	// NOTE: Changing this structure requires changes in `MarshalJSON` and `UnmarshalJSON` methods.
 	Body struct {
@@ -42,43 +62,102 @@ type (
 	DlPostResp struct {
 		ID string `json:"id"`
 	}
+	// abort by regex (see AdminBody.Regex): number of aborted jobs
+	AbortResp struct {
+		Aborted int `json:"aborted"`
+	}
+
+	// cancel individual item (see AdminBody.Item): number of cancelled pending and in-flight
+	// items; items that are yet to be dispatched get dropped (and counted) later, upon dispatch
+	CancelItemResp struct {
+		Cancelled int `json:"cancelled"`
+	}
 
 	Job struct {
-		ID            string    `json:"id"`
-		XactID        string    `json:"xaction_id"`
-		Description   string    `json:"description"`
-		StartedTime   time.Time `json:"started_time"`
-		FinishedTime  time.Time `json:"finished_time"`
-		FinishedCnt   int       `json:"finished_cnt"`
-		ScheduledCnt  int       `json:"scheduled_cnt"` // tasks being processed or already processed by dispatched
-		SkippedCnt    int       `json:"skipped_cnt"`   // number of tasks skipped
-		ErrorCnt      int       `json:"error_cnt"`
-		Total         int       `json:"total"`          // total number of tasks, negative if unknown
-		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
-		Aborted       bool      `json:"aborted"`
+		ID            string         `json:"id"`
+		XactID        string         `json:"xaction_id"`
+		ParentXactID  string         `json:"parent_xaction_id,omitempty"` // see Base.ParentXactID
+		Description   string         `json:"description"`
+		StartedTime   time.Time      `json:"started_time"`
+		FinishedTime  time.Time      `json:"finished_time"`
+		FinishedCnt   int            `json:"finished_cnt"`
+		ScheduledCnt  int            `json:"scheduled_cnt"`     // tasks being processed or already processed by dispatched
+		SkippedCnt    int            `json:"skipped_cnt"`       // number of tasks skipped (all reasons)
+		Skipped       map[string]int `json:"skipped,omitempty"` // SkippedCnt by reason (see SkipReason)
+		ErrorCnt      int            `json:"error_cnt"`
+		ErrSample     []string       `json:"error_sample,omitempty"`  // first and last (distinct) error messages (see errsample.go)
+		TimeoutCnt    int            `json:"timeout_cnt,omitempty"`   // request and item timeouts (the former are retried)
+		CancelledCnt  int            `json:"cancelled_cnt,omitempty"` // individually cancelled items (excluded from Total; see AdminBody.Item)
+		Total         int            `json:"total"`                   // total number of tasks, negative if unknown
+		Priority      int            `json:"priority"`                // higher-priority jobs get dispatched first
+		SpaceWait     bool           `json:"space_wait,omitempty"`    // waiting for free space (see Base.MinFreePct)
+		AllDispatched bool           `json:"all_dispatched"`          // if true, dispatcher has already scheduled all tasks for given job
+		Aborted       bool           `json:"aborted"`
+		Interrupted   bool           `json:"interrupted,omitempty"` // by target shutdown (see Xact.Shutdown); can be resumed
+		MerkleRoot    string         `json:"merkle_root,omitempty"` // see Base.Manifest
+		Webhook       *WebhookStatus `json:"webhook,omitempty"`     // see Base.Webhook
+		Hosts         []HostStats    `json:"hosts,omitempty"`       // see Limits.PerHost and Limits.HostDelay
+		NameTemplate  string         `json:"name_template,omitempty"`
 	}
 
 	JobInfos []*Job
 
+	// roll-up of all download jobs known to a given target (see also: Summary)
+	DlAggregate struct {
+		Jobs         int            `json:"jobs"`
+		Running      int            `json:"running"`
+		Finished     int            `json:"finished"`
+		Aborted      int            `json:"aborted"`
+		Interrupted  int            `json:"interrupted"`
+		ScheduledCnt int            `json:"scheduled_cnt"`
+		FinishedCnt  int            `json:"finished_cnt"`
+		SkippedCnt   int            `json:"skipped_cnt"`
+		Skipped      map[string]int `json:"skipped,omitempty"` // by reason (see Job.Skipped)
+		ErrorCnt     int            `json:"error_cnt"`
+		Paused       bool           `json:"paused,omitempty"` // see PauseAll
+	}
+
 	StatusResp struct {
 		Job
-		CurrentTasks  []TaskDlInfo  `json:"current_tasks,omitempty"`
-		FinishedTasks []TaskDlInfo  `json:"finished_tasks,omitempty"`
-		Errs          []TaskErrInfo `json:"download_errors,omitempty"`
+		CurrentTasks  []TaskDlInfo   `json:"current_tasks,omitempty"`
+		FinishedTasks []TaskDlInfo   `json:"finished_tasks,omitempty"`
+		Errs          []TaskErrInfo  `json:"download_errors,omitempty"`
+		Inflight      []DlItemStatus `json:"inflight,omitempty"`
 	}
 
 	Limits struct {
-		Connections  int `json:"connections"`
-		BytesPerHour int `json:"bytes_per_hour"`
+		Connections  int    `json:"connections"`
+		BytesPerHour int    `json:"bytes_per_hour"`
+		PerHost      int    `json:"per_host,omitempty"`   // max in-flight requests per source host (see polite.go)
+		HostDelay    string `json:"host_delay,omitempty"` // min spacing between requests to the same host, e.g. "500ms"
+	}
+
+	// per source host (see polite.go)
+	HostStats struct {
+		Host      string        `json:"host"`
+		Requests  int64         `json:"requests"`
+		Throttled int64         `json:"throttled,omitempty"` // 429 and 503 responses
+		Waited    time.Duration `json:"waited,omitempty"`    // total politeness wait
+		Delay     time.Duration `json:"delay,omitempty"`     // current, possibly backed off
 	}
 
 	Base struct {
 		Description      string      `json:"description"`
 		Bck              cmn.Bck     `json:"bucket"`
 		Timeout          string      `json:"timeout"`
+		ItemTimeout      string      `json:"item_timeout,omitempty"` // per object, all retries included (default: none)
 		ProgressInterval string      `json:"progress_interval"`
 		Limits           Limits      `json:"limits"`
 		Headers          http.Header `json:"headers,omitempty"`
+		Priority         int         `json:"priority,omitempty"`      // default: 0 (all jobs equal)
+		Manifest         bool        `json:"manifest,omitempty"`      // compute Merkle root over per-item checksums (see manifest.go)
+		Webhook          *Webhook    `json:"webhook,omitempty"`       // POST job summary upon completion (see webhook.go)
+		NameTemplate     string      `json:"name_template,omitempty"` // destination object naming (see nametmpl.go)
+		ParentXactID     string      `json:"parent_xid,omitempty"`    // attach to an existing (running) xaction (see parentXact)
+		MinFreePct       int         `json:"min_free_pct,omitempty"`  // pause writing when mountpath's free space is below (see space.go)
+		ChunkSize        cos.SizeIEC `json:"chunk_size,omitempty"`    // write larger (HTTP) sources as chunked objects (see chunked.go)
+		VerifyOnly       bool        `json:"verify_only,omitempty"`   // check that objects exist, never download (see verify.go)
+		VerifyJob        string      `json:"verify_job,omitempty"`    // (with VerifyOnly) compare against this job's manifest
 		// ETL fields
 		ETLName string `json:"etl_name,omitempty"`
 		ETLArgs string `json:"etl_args,omitempty"`
@@ -87,13 +166,28 @@ type (
 	SingleObj struct {
 		ObjName    string `json:"object_name"`
 		Link       string `json:"link"`
+		Range      string `json:"range,omitempty"` // download only the specified byte range, e.g. "0-1048575" (see byterange.go)
 		FromRemote bool   `json:"from_remote"`
 	}
 
 	AdminBody struct {
 		ID         string `json:"id"`
 		Regex      string `json:"regex"`
-		OnlyActive bool   `json:"only_active_tasks"` // Skips detailed info about tasks finished/errored
+		OnlyActive bool   `json:"only_active_tasks"`  // Skips detailed info about tasks finished/errored
+		Inflight   bool   `json:"inflight,omitempty"` // Include currently running items (see DlItemStatus)
+		Offset     int    `json:"offset,omitempty"`   // list jobs: number of jobs to skip (see JobPage)
+		Limit      int    `json:"limit,omitempty"`    // list jobs: page size; zero - no pagination
+		Item       string `json:"item,omitempty"`     // cancel-item: object name or source link (requires ID)
+		Priority   int    `json:"priority,omitempty"` // priority: new priority of a running job (requires ID)
+
+		// status: block (up to Wait) until the job is finished, aborted, or interrupted (see WaitForTerminal)
+		Wait time.Duration `json:"wait,omitempty"`
+	}
+
+	// paginated list of jobs sorted by start time (and ID); see AdminBody.Limit
+	JobPage struct {
+		Jobs  JobInfos `json:"jobs"`
+		Total int      `json:"total"` // all matching jobs
 	}
 
 	TaskDlInfo struct {
@@ -105,6 +199,19 @@ type (
 	}
 	TaskInfoByName []TaskDlInfo
 
+	// dispatched but not yet finished (debugging stalls)
+	DlItemStatus struct {
+		StartTime  time.Time `json:"start_time"`
+		Name       string    `json:"name"`
+		Link       string    `json:"link,omitempty"`
+		Mpath      string    `json:"mountpath"`
+		Downloaded int64     `json:"downloaded,string"`
+		Total      int64     `json:"total,string,omitempty"`
+		Stuck      bool      `json:"stuck,omitempty"`       // see watchdog
+		Chunks     int       `json:"chunks,omitempty"`      // chunked write (see Base.ChunkSize): total number of chunks
+		ChunksDone int       `json:"chunks_done,omitempty"` // ditto: written so far
+	}
+
 	TaskErrInfo struct {
 		Name string `json:"name"`
 		Err  string `json:"error"`
@@ -115,6 +222,7 @@ type (
 		Base
 		Prefix string `json:"prefix"`
 		Suffix string `json:"suffix"`
+		Regex  string `json:"regex,omitempty"` // in addition to prefix and suffix, object names must match
 		Sync   bool   `json:"synchronize"`
 	}
 
@@ -137,7 +245,7 @@ type (
 
 func IsType(a string) bool {
 	b := Type(a)
-	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange
+	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange || b == TypeImport
 }
 
 /////////
@@ -148,10 +256,19 @@ func (j *Job) Aggregate(rhs *Job) {
 	j.FinishedCnt += rhs.FinishedCnt
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
+	j.CancelledCnt += rhs.CancelledCnt
+	j.Skipped = mergeSkipped(j.Skipped, rhs.Skipped)
 	j.ErrorCnt += rhs.ErrorCnt
+	j.ErrSample = mergeErrSample(j.ErrSample, rhs.ErrSample)
+	j.TimeoutCnt += rhs.TimeoutCnt
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
 	j.Aborted = j.Aborted || rhs.Aborted
+	j.Interrupted = j.Interrupted || rhs.Interrupted
+	j.SpaceWait = j.SpaceWait || rhs.SpaceWait
+	j.MerkleRoot = xorRoots(j.MerkleRoot, rhs.MerkleRoot)
+	j.Webhook = j.Webhook.aggregate(rhs.Webhook)
+	j.Hosts = mergeHostStats(j.Hosts, rhs.Hosts)
 	if j.StartedTime.After(rhs.StartedTime) {
 		j.StartedTime = rhs.StartedTime
 	}
@@ -171,7 +288,7 @@ func (j *Job) JobFinished() bool {
 	if _isRunning(j.FinishedTime) {
 		return false
 	}
-	debug.Assert(j.Aborted || (j.AllDispatched && j.ScheduledCnt == j.DoneCnt()))
+	debug.Assert(j.Aborted || j.Interrupted || (j.AllDispatched && j.ScheduledCnt == j.DoneCnt()))
 	return true
 }
 
@@ -215,6 +332,8 @@ func (j *Job) String() string {
 	switch {
 	case j.Aborted:
 		sb.WriteString("aborted")
+	case j.Interrupted:
+		sb.WriteString("interrupted")
 	case finished:
 		sb.WriteString("finished")
 	default:
@@ -272,6 +391,51 @@ func (d JobInfos) Swap(i, j int) {
 	d[i], d[j] = d[j], d[i]
 }
 
+// stable across calls (and targets) - see JobPage
+func (d JobInfos) SortByStart() {
+	sort.Slice(d, func(i, j int) bool { return lessByStart(d[i].StartedTime, d[j].StartedTime, d[i].ID, d[j].ID) })
+}
+
+func lessByStart(ti, tj time.Time, idi, idj string) bool {
+	if ti.Equal(tj) {
+		return idi < idj
+	}
+	return ti.Before(tj)
+}
+
+/////////////////
+// DlAggregate //
+/////////////////
+
+// Merge combines per-target summaries: counters add up while
+// job counts don't (every job runs on all targets)
+func (a *DlAggregate) Merge(rhs *DlAggregate) {
+	a.Jobs = max(a.Jobs, rhs.Jobs)
+	a.Running = max(a.Running, rhs.Running)
+	a.Finished = max(a.Finished, rhs.Finished)
+	a.Aborted = max(a.Aborted, rhs.Aborted)
+	a.Interrupted = max(a.Interrupted, rhs.Interrupted)
+	a.ScheduledCnt += rhs.ScheduledCnt
+	a.FinishedCnt += rhs.FinishedCnt
+	a.SkippedCnt += rhs.SkippedCnt
+	a.Skipped = mergeSkipped(a.Skipped, rhs.Skipped)
+	a.ErrorCnt += rhs.ErrorCnt
+	a.Paused = a.Paused || rhs.Paused
+}
+
+func mergeSkipped(a, b map[string]int) map[string]int {
+	if len(b) == 0 {
+		return a
+	}
+	if a == nil {
+		a = make(map[string]int, len(b))
+	}
+	for reason, n := range b {
+		a[reason] += n
+	}
+	return a
+}
+
 ////////////////
 // StatusResp //
 ////////////////
@@ -287,6 +451,7 @@ func (d *StatusResp) Aggregate(rhs *StatusResp) *StatusResp {
 	d.CurrentTasks = append(d.CurrentTasks, rhs.CurrentTasks...)
 	d.FinishedTasks = append(d.FinishedTasks, rhs.FinishedTasks...)
 	d.Errs = append(d.Errs, rhs.Errs...)
+	d.Inflight = append(d.Inflight, rhs.Inflight...)
 	return d
 }
 
@@ -303,12 +468,49 @@ func (b *Base) Validate() error {
 			return fmt.Errorf("failed to parse timeout field: %v", err)
 		}
 	}
+	if b.ItemTimeout != "" {
+		d, err := time.ParseDuration(b.ItemTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse item_timeout field: %v", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("'item_timeout' must be non-negative (got: %v)", d)
+		}
+	}
 	if b.Limits.Connections < 0 {
 		return fmt.Errorf("'limit.connections' must be non-negative (got: %d)", b.Limits.Connections)
 	}
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.Limits.PerHost < 0 {
+		return fmt.Errorf("'limit.per_host' must be non-negative (got: %d)", b.Limits.PerHost)
+	}
+	if b.Limits.HostDelay != "" {
+		d, err := time.ParseDuration(b.Limits.HostDelay)
+		if err != nil || d < 0 || d > hostDelayMax {
+			return fmt.Errorf("invalid 'limit.host_delay' %q (expecting duration in the range [0, %v])", b.Limits.HostDelay, hostDelayMax)
+		}
+	}
+	if b.MinFreePct < 0 || b.MinFreePct > 99 {
+		return fmt.Errorf("'min_free_pct' must be in the range [0, 99] (got: %d)", b.MinFreePct)
+	}
+	if b.VerifyJob != "" && !b.VerifyOnly {
+		return errors.New("'verify_job' requires 'verify_only'")
+	}
+	if b.VerifyOnly && b.ETLName != "" {
+		return errors.New("'verify_only' and 'etl_name' are mutually exclusive")
+	}
+	if b.ChunkSize != 0 && (b.ChunkSize < MinChunkSize || b.ChunkSize > MaxChunkSize) {
+		return fmt.Errorf("'chunk_size' must be in the range [%s, %s] (got: %s)",
+			cos.IEC(MinChunkSize, 0), cos.IEC(MaxChunkSize, 0), b.ChunkSize)
+	}
+	if _, err := newNameTmpl(b.NameTemplate); err != nil {
+		return err
+	}
+	if b.Webhook != nil {
+		return b.Webhook.validate()
+	}
 	return nil
 }
 
@@ -330,6 +532,14 @@ func (b *SingleObj) Validate() error {
 	if b.ObjName == "" {
 		return errors.New("missing 'object_name' in the request body")
 	}
+	if b.Range != "" {
+		if b.FromRemote {
+			return errors.New("'range' is not supported when downloading from remote bucket")
+		}
+		if _, err := parseDlRange(b.Range); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -341,6 +551,16 @@ func (b *AdminBody) Validate(requireID bool) error {
 	switch {
 	case b.ID != "" && b.Regex != "":
 		return fmt.Errorf("regex %q and job ID %q cannot be defined together (choose one or the other)", b.Regex, b.ID)
+	case b.Item != "" && b.ID == "":
+		return fmt.Errorf("cannot cancel item %q: job ID not specified", b.Item)
+	case b.Offset < 0 || b.Limit < 0:
+		return fmt.Errorf("invalid offset (%d) and/or limit (%d)", b.Offset, b.Limit)
+	case b.Offset > 0 && b.Limit == 0:
+		return fmt.Errorf("offset (%d) requires limit", b.Offset)
+	case b.Wait < 0 || b.Wait > MaxStatusWait:
+		return fmt.Errorf("invalid wait %v (expecting 0 to %v)", b.Wait, MaxStatusWait)
+	case b.Wait > 0 && b.ID == "":
+		return fmt.Errorf("wait (%v) requires job ID", b.Wait)
 	case b.Regex != "":
 		if _, err := regexp.CompilePOSIX(b.Regex); err != nil {
 			return err
@@ -477,7 +697,17 @@ func (b *MultiBody) String() string {
 // BackendBody //
 /////////////////
 
-func (b *BackendBody) Validate() error { return b.Base.Validate() }
+func (b *BackendBody) Validate() error {
+	if b.Regex != "" {
+		if _, err := regexp.Compile(b.Regex); err != nil {
+			return fmt.Errorf("invalid 'regex' %q: %v", b.Regex, err)
+		}
+	}
+	if b.Sync && b.VerifyOnly {
+		return errors.New("'synchronize' and 'verify_only' are mutually exclusive")
+	}
+	return b.Base.Validate()
+}
 
 func (b *BackendBody) Describe() string {
 	if b.Description != "" {
@@ -0,0 +1,117 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseDlRange(t *testing.T) {
+	tests := []struct {
+		s          string
+		start, end int64
+		str        string
+		valid      bool
+	}{
+		{"0-99", 0, 99, "0-99", true},
+		{"bytes=100-199", 100, 199, "100-199", true},
+		{" 5-5 ", 5, 5, "5-5", true},
+		{"1024-", 1024, -1, "1024-", true},
+		{"-100", 0, 0, "", false}, // suffix ranges are not supported
+		{"100", 0, 0, "", false},
+		{"10-5", 0, 0, "", false},
+		{"0-9,20-29", 0, 0, "", false},
+		{"a-b", 0, 0, "", false},
+		{"0-b", 0, 0, "", false},
+	}
+	for _, test := range tests {
+		rng, err := parseDlRange(test.s)
+		if !test.valid {
+			tassert.Errorf(t, err != nil, "%q: expecting error", test.s)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, rng.start == test.start && rng.end == test.end, "%q: expecting [%d, %d], got [%d, %d]",
+			test.s, test.start, test.end, rng.start, rng.end)
+		tassert.Errorf(t, rng.String() == test.str, "%q: expecting %q, got %q", test.s, test.str, rng.String())
+	}
+
+	rng, err := parseDlRange("")
+	tassert.Errorf(t, rng == nil && err == nil, "empty: expecting no range")
+	tassert.Errorf(t, rng.String() == "", "nil-safe String()")
+}
+
+func TestDlRangeRequest(t *testing.T) {
+	data := bytes.Repeat([]byte("abcdefghij"), 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/norange" {
+			w.Write(data)
+			return
+		}
+		http.ServeContent(w, r, "obj", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	get := func(path string, rng *dlRange) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+path, http.NoBody)
+		tassert.CheckFatal(t, err)
+		rng.setHdr(req.Header)
+		resp, err := http.DefaultClient.Do(req)
+		tassert.CheckFatal(t, err)
+		return resp
+	}
+
+	for _, s := range []string{"100-199", "990-", "0-0"} {
+		rng, err := parseDlRange(s)
+		tassert.CheckFatal(t, err)
+		resp := get("/obj", rng)
+		size, err := rng.check(resp)
+		tassert.CheckFatal(t, err)
+		b, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		end := rng.end + 1
+		if rng.end < 0 {
+			end = int64(len(data))
+		}
+		tassert.Errorf(t, size == end-rng.start && bytes.Equal(b, data[rng.start:end]), "%s: unexpected slice (size %d)", s, size)
+	}
+
+	// source ignores the range: fail rather than store the whole thing
+	rng, _ := parseDlRange("0-9")
+	resp := get("/norange", rng)
+	resp.Body.Close()
+	_, err := rng.check(resp)
+	tassert.Errorf(t, errors.Is(err, errRangeIgnored), "expecting range ignored, got %v", err)
+
+	// wrong slice
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes 10-19/1000")
+	_, err = rng.check(resp)
+	tassert.Errorf(t, err != nil, "expecting unexpected partial content error")
+}
+
+func TestSingleObjRange(t *testing.T) {
+	tests := []struct {
+		obj   SingleObj
+		valid bool
+	}{
+		{SingleObj{Link: "http://host/obj", Range: "0-99"}, true},
+		{SingleObj{Link: "http://host/obj", Range: "99-0"}, false},
+		{SingleObj{ObjName: "obj", FromRemote: true, Range: "0-99"}, false},
+	}
+	for _, test := range tests {
+		err := test.obj.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, got %v", test.obj, test.valid, err)
+	}
+}
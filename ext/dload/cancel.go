@@ -0,0 +1,137 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Cancel individual item of a running job (see AdminBody.Item)
+//
+// Unlike abort, drops a single (misbehaving) entry while the rest of the job proceeds.
+// The item is identified by its destination object name or its source link:
+// - queued: removed from the jogger's queue (same way abort-job removes all)
+// - in-flight: download gets canceled (and is not counted as an error)
+// - not yet dispatched: remembered for the lifetime of the job, and dropped upon dispatch
+// Either way, the item counts as cancelled and is subtracted from the job's total (if known).
+// NOTE: not persisted - a resumed (see resume.go) job starts with an empty set.
+
+type cancelSet struct {
+	m  cos.StrSet
+	mu sync.RWMutex
+}
+
+func (cs *cancelSet) add(item string) {
+	cs.mu.Lock()
+	if cs.m == nil {
+		cs.m = make(cos.StrSet, 4)
+	}
+	cs.m.Set(item)
+	cs.mu.Unlock()
+}
+
+func (cs *cancelSet) has(obj *dlObj) (yes bool) {
+	cs.mu.RLock()
+	if len(cs.m) > 0 {
+		yes = obj.is(cs.m)
+	}
+	cs.mu.RUnlock()
+	return yes
+}
+
+func (obj *dlObj) is(items cos.StrSet) bool {
+	return items.Contains(obj.objName) || (obj.link != "" && items.Contains(obj.link))
+}
+
+//
+// infoStore
+//
+
+func (is *infoStore) isCancelled(id string, obj *dlObj) bool {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	return dljob != nil && dljob.cancelled.has(obj)
+}
+
+// (scheduled => cancelled)
+func (is *infoStore) incCancelled(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.cancelledCnt.Inc()
+	dljob.scheduledCnt.Dec()
+	for n := dljob.total.Load(); n > 0 && !dljob.total.CAS(n, n-1); n = dljob.total.Load() {
+	}
+	subs.notify(dljob)
+}
+
+//
+// dispatcher and joggers
+//
+
+func (d *dispatcher) handleCancelItem(req *request) {
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		return
+	}
+	if dljob.aborted.Load() || !_isRunning(dljob.finishedTime.Load()) {
+		// (may have finished on this target while still running elsewhere)
+		req.okRsp(&CancelItemResp{})
+		return
+	}
+	if req.item == "" {
+		req.errRsp(fmt.Errorf("job %q: item to cancel not specified", req.id), http.StatusBadRequest)
+		return
+	}
+	dljob.cancelled.add(req.item)
+
+	var n int
+	for _, j := range d.joggers {
+		n += j.cancelItem(req.id, req.item)
+	}
+	req.okRsp(&CancelItemResp{Cancelled: n})
+}
+
+// cancel in-flight and remove queued; returns the number of both
+func (j *jogger) cancelItem(jobID, item string) (n int) {
+	var (
+		items  = cos.NewStrSet(item)
+		queued int
+	)
+	j.mtx.Lock()
+	for _, t := range j.tasks {
+		if t.jobID() == jobID && t.obj.is(items) && t.cancelled.CAS(false, true) {
+			t.cancel() // (counted upon return - see singleTask.download)
+			n++
+		}
+	}
+	// running tasks remain in the queue's set until finished - skip them
+	j.q.mu.Lock()
+	for uid, t := range j.q.m[jobID] {
+		if t.cancelled.Load() || !t.obj.is(items) {
+			continue
+		}
+		j.q.removeFromSet(jobID, uid) // jogger won't run it (see taskExists)
+		g.store.incCancelled(jobID)
+		queued++
+	}
+	j.q.mu.Unlock()
+	j.mtx.Unlock()
+
+	if queued > 0 {
+		j.parent.xdl.SubPending(queued)
+	}
+	if n += queued; n > 0 && cmn.Rom.V(4, cos.ModDload) {
+		nlog.Infof("%s: cancel-item[%s, %q, mpath=%s]: %d", core.T.String(), jobID, item, j.mpath, n)
+	}
+	return n
+}
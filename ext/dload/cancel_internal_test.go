@@ -0,0 +1,142 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCancelSet(t *testing.T) {
+	var (
+		cs  cancelSet
+		obj = &dlObj{objName: "a", link: "http://host/x/a"}
+	)
+	tassert.Errorf(t, !cs.has(obj), "empty: not expecting cancelled")
+
+	cs.add("b")
+	tassert.Errorf(t, !cs.has(obj), "not expecting cancelled")
+	cs.add("http://host/x/a")
+	tassert.Errorf(t, cs.has(obj), "expecting cancelled by link")
+	tassert.Errorf(t, cs.has(&dlObj{objName: "b", link: "http://host/b"}), "expecting cancelled by name")
+	tassert.Errorf(t, !cs.has(&dlObj{objName: "c"}), "not expecting cancelled (no link)")
+}
+
+func TestIncCancelled(t *testing.T) {
+	testStore(t, map[string]int{"known": 0, "unknown": 0})
+	known, unknown := g.store.dljobs["known"], g.store.dljobs["unknown"]
+	known.total.Store(2)
+	unknown.total.Store(-1)
+	for range 3 {
+		g.store.incScheduled("known")
+		g.store.incCancelled("known")
+	}
+	g.store.incScheduled("unknown")
+	g.store.incCancelled("unknown")
+
+	job := known.clone()
+	tassert.Errorf(t, job.CancelledCnt == 3 && job.ScheduledCnt == 0, "expecting 3 cancelled (none scheduled), got %+v", job)
+	tassert.Errorf(t, job.Total == 0, "expecting total to stop at zero, got %d", job.Total)
+	job = unknown.clone()
+	tassert.Errorf(t, job.CancelledCnt == 1 && job.Total == -1, "expecting unknown total to remain unknown, got %+v", job)
+}
+
+func TestCancelItem(t *testing.T) {
+	const jobID = "job"
+	var (
+		bck = testTarget(t)
+		xdl = &Xact{}
+		d   = &dispatcher{
+			xdl:      xdl,
+			joggers:  make(map[string]*jogger, 1),
+			stopCh:   cos.NewStopCh(),
+			drainCh:  cos.NewStopCh(),
+			abortJob: map[string]*cos.StopCh{jobID: cos.NewStopCh()},
+		}
+		j     = newJogger(d, "/tmp/mpath")
+		job   = &sliceDlJob{baseDlJob: baseDlJob{id: jobID, bck: bck}}
+		other = &sliceDlJob{baseDlJob: baseDlJob{id: "other", bck: bck}}
+	)
+	xdl.InitBase(cos.GenUUID(), apc.ActDownload, nil)
+	d.joggers[j.mpath] = j
+	testStore(t, map[string]int{jobID: 0, "other": 0, "fin": 0})
+	g.store.dljobs[jobID].total.Store(5)
+
+	// queued: a, b, and the same `a` of another job
+	queued := []*singleTask{
+		{job: job, obj: dlObj{objName: "a", link: "http://host/a"}},
+		{job: job, obj: dlObj{objName: "b", link: "http://host/b"}},
+		{job: other, obj: dlObj{objName: "a", link: "http://host/a"}},
+	}
+	j.q.mu.Lock()
+	for _, task := range queued {
+		j.q.putToSet(task)
+	}
+	j.q.mu.Unlock()
+
+	// in-flight: c (by link)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	running := &singleTask{job: job, obj: dlObj{objName: "c", link: "http://host/c"}, cancel: cancel}
+	j.tasks = append(j.tasks, running)
+
+	cancelItem := func(id, item string) *request {
+		req := &request{action: actCancel, id: id, item: item}
+		d.handleCancelItem(req)
+		return req
+	}
+
+	req := cancelItem(jobID, "a")
+	tassert.Fatalf(t, req.response.err == nil, "unexpected error: %v", req.response.err)
+	tassert.Errorf(t, req.response.value.(*CancelItemResp).Cancelled == 1, "expecting 1 cancelled, got %+v", req.response.value)
+	j.q.mu.RLock()
+	tassert.Errorf(t, !j.q.exists(jobID, queued[0].uid()) && j.q.exists(jobID, queued[1].uid()),
+		"expecting `a` (only) removed from the queue")
+	tassert.Errorf(t, j.q.exists("other", queued[2].uid()), "not expecting another job's item removed")
+	j.q.mu.RUnlock()
+	dljob := g.store.dljobs[jobID].clone()
+	tassert.Errorf(t, dljob.CancelledCnt == 1 && dljob.Total == 4, "expecting 1 cancelled out of 4, got %+v", dljob)
+
+	req = cancelItem(jobID, "http://host/c")
+	tassert.Errorf(t, req.response.value.(*CancelItemResp).Cancelled == 1, "expecting in-flight cancelled, got %+v", req.response.value)
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Error("expecting in-flight download canceled")
+	}
+	tassert.Errorf(t, running.cancelled.Load(), "expecting in-flight marked cancelled")
+	// (counted upon return - see singleTask.download)
+	tassert.Errorf(t, g.store.dljobs[jobID].clone().CancelledCnt == 1, "not expecting in-flight counted yet")
+
+	// (once)
+	req = cancelItem(jobID, "c")
+	tassert.Errorf(t, req.response.value.(*CancelItemResp).Cancelled == 0, "expecting none cancelled, got %+v", req.response.value)
+
+	// not dispatched yet: dropped upon dispatch
+	req = cancelItem(jobID, "http://host/later")
+	tassert.Errorf(t, req.response.value.(*CancelItemResp).Cancelled == 0, "expecting none cancelled, got %+v", req.response.value)
+	tassert.Errorf(t, g.store.isCancelled(jobID, &dlObj{objName: "later", link: "http://host/later"}),
+		"expecting remembered for the lifetime of the job")
+	tassert.Errorf(t, !g.store.isCancelled("other", &dlObj{objName: "later", link: "http://host/later"}),
+		"not expecting another job's item cancelled")
+
+	// errors and no-ops
+	req = cancelItem(jobID, "")
+	tassert.Errorf(t, req.response.statusCode == http.StatusBadRequest, "expecting %d, got %d", http.StatusBadRequest,
+		req.response.statusCode)
+	req = cancelItem("no-such-job", "a")
+	tassert.Errorf(t, req.response.statusCode == http.StatusNotFound, "expecting %d, got %d", http.StatusNotFound,
+		req.response.statusCode)
+	g.store.dljobs["fin"].finishedTime.Store(time.Now())
+	req = cancelItem("fin", "a")
+	tassert.Errorf(t, req.response.err == nil && req.response.value.(*CancelItemResp).Cancelled == 0,
+		"finished: expecting no-op, got %+v", req.response)
+}
@@ -0,0 +1,239 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Chunked writes (see Base.ChunkSize)
+//
+// HTTP(S) sources larger than the job's chunk size are written as chunked objects
+// (core.Ufest) - one chunk at a time, each with its own checksum; the partial manifest
+// gets checkpointed after every chunk. When the transfer breaks mid-object, the retry
+// (see downloadLocal) requests only the remaining bytes ("Range: bytes=<offset>-",
+// guarded by If-Range when the source provided a validator) and continues with the
+// next chunk. A source that ignores the range (or has changed) restarts from scratch.
+// - the manifest ID is derived from (job ID, object name), to locate a partial upload
+// - when the item fails, the partial upload is removed
+// - DlItemStatus (in-flight view) shows chunk progress
+// - ETL and remote-backend downloads are not affected
+
+const (
+	MinChunkSize = cos.MiB
+	MaxChunkSize = 5 * cos.GiB
+)
+
+const hdrIfRange = "If-Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-3.2
+
+var errChunkIO = errors.New("chunked write interrupted")
+
+type dlChunked struct {
+	u         *core.Ufest
+	whole     *cos.CksumHash // destination bucket's type, computed inline (nil: none, or invalidated - see _dputChunked)
+	ifRange   string         // validator from the first response (If-Range on resume)
+	cksumType string         // per-chunk
+	size      int64          // total (source) size
+	csize     int64          // effective chunk size
+}
+
+func (task *singleTask) chunkable(size int64) bool {
+	csize := task.job.ChunkSize()
+	return csize > 0 && size > csize
+}
+
+func newDlChunked(task *singleTask, lom *core.LOM, resp *http.Response, size int64) (*dlChunked, error) {
+	id := "dl-" + task.jobID() + "-" + strconv.FormatUint(onexxh.Checksum64S(cos.UnsafeB(lom.ObjName), cos.MLCG32), 36)
+	u, err := core.NewUfest(id, lom, false /*must-exist*/)
+	if err != nil {
+		return nil, err
+	}
+	ck := &dlChunked{u: u, size: size, cksumType: lom.CksumConf().Type}
+	if ck.cksumType == cos.ChecksumNone {
+		ck.cksumType = cos.ChecksumOneXxh
+	} else {
+		ck.whole = cos.NewCksumHash(ck.cksumType)
+	}
+	// respect max number of chunks
+	ck.csize = max(task.job.ChunkSize(), (size+core.MaxChunkCount-1)/core.MaxChunkCount)
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+		ck.ifRange = etag
+	} else {
+		ck.ifRange = resp.Header.Get(cos.HdrLastModified)
+	}
+	task.numChunks.Store(int32((size + ck.csize - 1) / ck.csize))
+	task.chunksDone.Store(0)
+	return ck, nil
+}
+
+// nil-safe
+func (ck *dlChunked) offset() int64 {
+	if ck == nil {
+		return 0
+	}
+	return ck.u.Size()
+}
+
+func (ck *dlChunked) setRange(hdr http.Header) {
+	hdr.Set(cos.HdrRange, cos.HdrRangeValPrefix+strconv.FormatInt(ck.u.Size(), 10)+"-")
+	if ck.ifRange != "" {
+		hdr.Set(hdrIfRange, ck.ifRange)
+	}
+}
+
+// the source has honored the range request
+func (ck *dlChunked) resumed(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusPartialContent {
+		return false
+	}
+	start, total, ok := parseContentRange(resp.Header.Get(cos.HdrContentRange))
+	return ok && start == ck.u.Size() && total == ck.size
+}
+
+// "bytes <start>-<end>/<total>"
+func parseContentRange(s string) (start, total int64, ok bool) {
+	s, ok = strings.CutPrefix(s, cos.HdrContentRangeValPrefix)
+	if !ok {
+		return 0, 0, false
+	}
+	rng, tot, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, 0, false
+	}
+	first, _, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	var err error
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+		return 0, 0, false
+	}
+	if total, err = strconv.ParseInt(tot, 10, 64); err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
+
+// (compare with _dput)
+func (task *singleTask) _dputChunked(lom *core.LOM, resp *http.Response, r io.Reader, size int64) (bool /*err is fatal*/, error) {
+	var err error
+	if ck := task.chunked; ck != nil && !ck.resumed(resp) {
+		nlog.Warningln(task.String(), "- source did not honor range request at offset", ck.u.Size(), "- restarting")
+		task.abortChunked(lom)
+		task.currentSize.Store(0)
+	}
+	if task.chunked == nil {
+		if resp.StatusCode == http.StatusPartialContent {
+			// (not fatal: the retry requests the whole thing)
+			return false, fmt.Errorf("%w: unexpected partial content %q", errChunkIO, resp.Header.Get(cos.HdrContentRange))
+		}
+		if task.chunked, err = newDlChunked(task, lom, resp, size); err != nil {
+			return true, err
+		}
+	}
+	ck := task.chunked
+	task.setTotalSize(ck.size)
+
+	buf, slab := core.T.PageMM().Alloc()
+	defer slab.Free(buf)
+
+	for off := ck.u.Size(); off < ck.size; {
+		var (
+			num  = ck.u.Count() + 1
+			want = min(ck.csize, ck.size-off)
+		)
+		chunk, err := ck.u.NewChunk(num, lom)
+		if err != nil {
+			return true, err
+		}
+		fh, err := lom.CreatePart(chunk.Path())
+		if err != nil {
+			return true, err
+		}
+		cr := io.LimitReader(r, want)
+		if ck.whole != nil {
+			cr = io.TeeReader(cr, ck.whole.H)
+		}
+		n, cksum, err := cos.CopyAndChecksum(fh, cr, buf, ck.cksumType)
+		cos.Close(fh)
+		if err == nil && n < want {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			if nerr := cos.RemoveFile(chunk.Path()); nerr != nil {
+				nlog.Errorln("nested error removing chunk:", nerr)
+			}
+			// the (partial) chunk's bytes are already hashed: fall back to re-reading (see completeChunked)
+			ck.whole = nil
+			// not fatal: the retry resumes at this chunk
+			return false, fmt.Errorf("%w at chunk %d (offset %d): %w", errChunkIO, num, off, err)
+		}
+		chunk.SetCksum(&cksum.Cksum)
+		if err := ck.u.Add(chunk, n, int64(num)); err != nil {
+			return true, err
+		}
+		if err := ck.u.StorePartial(lom, false /*locked*/); err != nil {
+			return true, err
+		}
+		task.chunksDone.Inc()
+		off += n
+	}
+	return true, task.completeChunked(lom)
+}
+
+// (compare with xs.XactBlobDl._fini)
+func (task *singleTask) completeChunked(lom *core.LOM) (err error) {
+	var (
+		u     = task.chunked.u
+		whole = task.chunked.whole
+	)
+	lom.Lock(true)
+	switch ty := lom.CksumConf().Type; {
+	case ty == cos.ChecksumNone:
+	case whole != nil && whole.Ty() == ty:
+		whole.Finalize() // computed inline - no need to re-read
+		lom.SetCksum(&whole.Cksum)
+	default:
+		cksumH := cos.NewCksumHash(ty)
+		if err = u.ComputeWholeChecksum(cksumH); err == nil {
+			lom.SetCksum(&cksumH.Cksum)
+		}
+	}
+	if err == nil {
+		err = lom.CompleteUfest(u, true /*locked*/)
+	}
+	lom.Unlock(true)
+	if err != nil {
+		return err
+	}
+	task.chunked = nil
+	if cmn.Rom.V(4, cos.ModDload) {
+		nlog.Infoln(task.String(), "- completed", u.Count(), "chunks")
+	}
+	return lom.Load(true /*cache it*/, false /*locked*/)
+}
+
+// nil-safe
+func (task *singleTask) abortChunked(lom *core.LOM) {
+	if task.chunked == nil {
+		return
+	}
+	task.chunked.u.Abort(lom)
+	task.chunked = nil
+	task.numChunks.Store(0)
+	task.chunksDone.Store(0)
+}
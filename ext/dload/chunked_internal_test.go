@@ -0,0 +1,246 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// target with a single mountpath and a bucket (checksum: xxhash)
+func testMpath(t *testing.T) *meta.Bck {
+	bck := meta.NewBck("bck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh}})
+	savedT := core.T
+	tmock := mock.NewTarget(mock.NewBaseBownerMock(bck))
+	t.Cleanup(func() { core.T = savedT })
+
+	dir := t.TempDir()
+	config := cmn.GCO.BeginUpdate()
+	config.ConfigDir = dir
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	cmn.Rom.Set(&config.ClusterConfig)
+
+	fs.NewTestMFS(nil)
+	mpath := filepath.Join(dir, "mpath")
+	tassert.CheckFatal(t, cos.CreateDir(mpath))
+	mi, err := fs.AddTestMpath(mpath, tmock.SID())
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, mi.CreateMissingBckDirs(bck.Bucket()))
+	return bck
+}
+
+// fails after `n` bytes
+type testFlakyReader struct {
+	r io.Reader
+	n int
+}
+
+func (fr *testFlakyReader) Read(b []byte) (int, error) {
+	if fr.n <= 0 {
+		return 0, errors.New("connection reset")
+	}
+	if len(b) > fr.n {
+		b = b[:fr.n]
+	}
+	n, err := fr.r.Read(b)
+	fr.n -= n
+	return n, err
+}
+
+func TestChunkedResume(t *testing.T) {
+	const (
+		csize = 64 * cos.KiB
+		size  = 3*csize + 1000
+		etag  = `"v1"`
+	)
+	bck := testMpath(t)
+	testStore(t, map[string]int{"job": 0})
+
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	lom := core.AllocLOM("obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+
+	task := &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: "job", chunkSize: csize}}}
+	tassert.Fatalf(t, task.chunkable(size) && !task.chunkable(csize), "chunkable: expecting strictly greater than chunk size")
+
+	// 1. breaks in the middle of the 3rd chunk
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set(cos.HdrETag, etag)
+	fatal, err := task._dputChunked(lom, resp, &testFlakyReader{r: bytes.NewReader(data), n: 2*csize + 100}, size)
+	tassert.Fatalf(t, !fatal && errors.Is(err, errChunkIO), "expecting retriable chunk error, got %v (fatal=%t)", err, fatal)
+	tassert.Fatalf(t, task.chunked != nil && task.chunked.offset() == 2*csize, "expecting offset %d, got %d",
+		2*csize, task.chunked.offset())
+	tassert.Errorf(t, task.numChunks.Load() == 4 && task.chunksDone.Load() == 2, "expecting 2/4 chunks, got %d/%d",
+		task.chunksDone.Load(), task.numChunks.Load())
+
+	// 2. resume with the remaining bytes
+	hdr := http.Header{}
+	task.chunked.setRange(hdr)
+	tassert.Errorf(t, hdr.Get(cos.HdrRange) == "bytes="+strconv.Itoa(2*csize)+"-", "unexpected range %q", hdr.Get(cos.HdrRange))
+	tassert.Errorf(t, hdr.Get(hdrIfRange) == etag, "expecting If-Range %s, got %q", etag, hdr.Get(hdrIfRange))
+
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes "+strconv.Itoa(2*csize)+"-"+strconv.Itoa(size-1)+"/"+strconv.Itoa(size))
+	fatal, err = task._dputChunked(lom, resp, bytes.NewReader(data[2*csize:]), size-2*csize)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, fatal, "expecting done") // (no retries either way)
+	tassert.Errorf(t, task.chunked == nil, "expecting completed")
+
+	tassert.Fatalf(t, lom.IsChunked() && lom.Lsize() == size, "expecting chunked object of size %d, got %d (chunked=%t)",
+		size, lom.Lsize(), lom.IsChunked())
+	expected := cos.ChecksumB2S(data, cos.ChecksumOneXxh)
+	tassert.Errorf(t, lom.Checksum().Val() == expected, "expecting checksum %s, got %s", expected, lom.Checksum())
+}
+
+func TestChunkedRestart(t *testing.T) {
+	const (
+		csize = 64 * cos.KiB
+		size  = 2*csize + 10
+	)
+	bck := testMpath(t)
+	testStore(t, map[string]int{"job": 0})
+
+	data := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+	lom := core.AllocLOM("obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	task := &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: "job", chunkSize: csize}}}
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set(cos.HdrLastModified, "yesterday")
+	_, err := task._dputChunked(lom, resp, &testFlakyReader{r: bytes.NewReader(data), n: csize + 1}, size)
+	tassert.Fatalf(t, errors.Is(err, errChunkIO), "expecting chunk error, got %v", err)
+	hdr := http.Header{}
+	task.chunked.setRange(hdr)
+	tassert.Errorf(t, hdr.Get(hdrIfRange) == "yesterday", "expecting If-Range: Last-Modified, got %q", hdr.Get(hdrIfRange))
+
+	// the source ignores the range and sends the whole thing: start over
+	resp = &http.Response{StatusCode: http.StatusOK}
+	_, err = task._dputChunked(lom, resp, bytes.NewReader(data), size)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, lom.Lsize() == size, "expecting size %d, got %d", size, lom.Lsize())
+	tassert.Errorf(t, lom.Checksum().Val() == cos.ChecksumB2S(data, cos.ChecksumOneXxh), "checksum mismatch")
+
+	// unsolicited partial content (no chunked write in progress)
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes 0-9/100")
+	fatal, err := task._dputChunked(lom, resp, bytes.NewReader(data), size)
+	tassert.Errorf(t, !fatal && errors.Is(err, errChunkIO), "expecting retriable error, got %v", err)
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		s            string
+		start, total int64
+		ok           bool
+	}{
+		{"bytes 0-99/100", 0, 100, true},
+		{"bytes 100-199/1000", 100, 1000, true},
+		{"bytes 0-99/*", 0, 0, false},
+		{"0-99/100", 0, 0, false},
+		{"bytes 0/100", 0, 0, false},
+		{"bytes x-99/100", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, test := range tests {
+		start, total, ok := parseContentRange(test.s)
+		tassert.Errorf(t, ok == test.ok && start == test.start && total == test.total, "%q: expecting (%d, %d, %t), got (%d, %d, %t)",
+			test.s, test.start, test.total, test.ok, start, total, ok)
+	}
+}
+
+func TestChunkedCksumType(t *testing.T) {
+	const (
+		csize = 64 * cos.KiB
+		size  = 2*csize + 100
+	)
+	bck := testMpath(t)
+	testStore(t, map[string]int{"job": 0})
+
+	data := bytes.Repeat([]byte("abcdefgh"), size/8+1)[:size]
+	newTask := func(name string) (*singleTask, *core.LOM) {
+		lom := core.AllocLOM(name)
+		t.Cleanup(func() { core.FreeLOM(lom) })
+		tassert.CheckFatal(t, lom.InitBck(bck))
+		return &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: "job", bck: bck, chunkSize: csize}}}, lom
+	}
+	whole := func(task *singleTask) *cos.CksumHash { return task.chunked.whole }
+
+	// destination bucket's type: computed inline and stored with the object
+	bck.Props.Cksum.Type = cos.ChecksumSHA256
+	task, lom := newTask("sha")
+	tassert.Errorf(t, task.job.CksumType() == cos.ChecksumSHA256, "expecting job's checksum type %s, got %s",
+		cos.ChecksumSHA256, task.job.CksumType())
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	var err error
+	task.chunked, err = newDlChunked(task, lom, resp, size)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, task.chunked.cksumType == cos.ChecksumSHA256, "expecting per-chunk %s, got %s",
+		cos.ChecksumSHA256, task.chunked.cksumType)
+	tassert.Errorf(t, whole(task) != nil && whole(task).Ty() == cos.ChecksumSHA256, "expecting inline %s", cos.ChecksumSHA256)
+
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes 0-"+strconv.Itoa(size-1)+"/"+strconv.Itoa(size))
+	_, err = task._dputChunked(lom, resp, bytes.NewReader(data), size)
+	tassert.CheckFatal(t, err)
+	expected := cos.ChecksumB2S(data, cos.ChecksumSHA256)
+	tassert.Errorf(t, lom.Checksum().Ty() == cos.ChecksumSHA256 && lom.Checksum().Val() == expected,
+		"expecting %s checksum %s, got %s", cos.ChecksumSHA256, expected, lom.Checksum())
+
+	// interrupted mid-chunk: the inline hash is no longer usable - re-read upon completion
+	task, lom = newTask("reread")
+	resp = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	_, err = task._dputChunked(lom, resp, &testFlakyReader{r: bytes.NewReader(data), n: csize + 10}, size)
+	tassert.Fatalf(t, errors.Is(err, errChunkIO), "expecting chunk error, got %v", err)
+	tassert.Errorf(t, whole(task) == nil, "expecting inline checksum invalidated")
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes "+strconv.Itoa(csize)+"-"+strconv.Itoa(size-1)+"/"+strconv.Itoa(size))
+	_, err = task._dputChunked(lom, resp, bytes.NewReader(data[csize:]), size-csize)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, lom.Checksum().Val() == expected, "re-read: expecting checksum %s, got %s", expected, lom.Checksum())
+
+	// bucket's type changes while downloading: re-read with the new one
+	task, lom = newTask("changed")
+	resp = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	task.chunked, err = newDlChunked(task, lom, resp, size)
+	tassert.CheckFatal(t, err)
+	bck.Props.Cksum.Type = cos.ChecksumOneXxh
+	resp = &http.Response{StatusCode: http.StatusPartialContent, Header: http.Header{}}
+	resp.Header.Set(cos.HdrContentRange, "bytes 0-"+strconv.Itoa(size-1)+"/"+strconv.Itoa(size))
+	_, err = task._dputChunked(lom, resp, bytes.NewReader(data), size)
+	tassert.CheckFatal(t, err)
+	expected = cos.ChecksumB2S(data, cos.ChecksumOneXxh)
+	tassert.Errorf(t, lom.Checksum().Ty() == cos.ChecksumOneXxh && lom.Checksum().Val() == expected,
+		"expecting %s checksum %s, got %s", cos.ChecksumOneXxh, expected, lom.Checksum())
+
+	// none: per-chunk xxhash, nothing computed for the whole
+	bck.Props.Cksum.Type = cos.ChecksumNone
+	task, lom = newTask("none")
+	resp = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	task.chunked, err = newDlChunked(task, lom, resp, size)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, task.chunked.cksumType == cos.ChecksumOneXxh && whole(task) == nil,
+		"none: expecting per-chunk %s and no inline checksum", cos.ChecksumOneXxh)
+	task.abortChunked(lom)
+}
@@ -6,6 +6,7 @@ package dload
 
 import (
 	"errors"
+	"fmt"
 	"path"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 const (
 	downloaderErrors     = "errors"
 	downloaderTasks      = "tasks"
+	downloaderJobs       = "jobs" // start requests (see resume.go)
 	downloaderCollection = "downloads"
 
 	// Number of errors stored in memory. When the number of errors exceeds
@@ -28,26 +30,78 @@ const (
 	taskInfoCacheSize = 1000
 )
 
-var errJobNotFound = errors.New("job not found")
+var (
+	errJobNotFound = errors.New("job not found")
+	errInterrupted = errors.New("interrupted by shutdown")
+	errNotModified = errors.New("not modified") // conditional GET: source unchanged
+)
+
+// Namespace (see Init)
+// - when non-empty, prefixes all keys: "<namespace>/errors/<job-id>", etc.
+// - empty namespace is the legacy (un-prefixed) layout
+// - upon startup with a namespace, existing un-prefixed records are moved into it (see migrate)
 
 type downloaderDB struct {
 	mtx    sync.RWMutex
 	driver kvdb.Driver
+	ns     string
 
 	errCache      map[string][]TaskErrInfo // memory cache for errors, see: errCacheSize
 	taskInfoCache map[string][]TaskDlInfo  // memory cache for tasks, see: taskInfoCacheSize
 }
 
-func newDownloadDB(driver kvdb.Driver) *downloaderDB {
-	return &downloaderDB{
+func newDownloadDB(driver kvdb.Driver, ns string) *downloaderDB {
+	db := &downloaderDB{
 		driver:        driver,
+		ns:            ns,
 		errCache:      make(map[string][]TaskErrInfo, 10),
 		taskInfoCache: make(map[string][]TaskDlInfo, 10),
 	}
+	if ns != "" {
+		db.migrate()
+	}
+	return db
+}
+
+func validateNamespace(ns string) error {
+	if ns == "" {
+		return nil
+	}
+	if ns == downloaderErrors || ns == downloaderTasks || ns == downloaderJobs {
+		return fmt.Errorf("downloader namespace %q is reserved", ns)
+	}
+	return cos.CheckAlphaPlus(ns, "downloader namespace")
+}
+
+func (db *downloaderDB) key(kind, id string) string { return path.Join(db.ns, kind, id) }
+
+// one-time: move legacy (un-namespaced) records into the namespace
+func (db *downloaderDB) migrate() {
+	var n int
+	for _, kind := range []string{downloaderErrors, downloaderTasks, downloaderJobs} {
+		recs, code, err := db.driver.GetAll(downloaderCollection, kind+"/")
+		if err != nil {
+			if !cos.IsNotExist(err) {
+				nlog.Errorln("downloader: failed to list legacy records:", err, code)
+			}
+			continue
+		}
+		for key, val := range recs {
+			if _, err := db.driver.SetString(downloaderCollection, path.Join(db.ns, key), val); err != nil {
+				nlog.Errorln("downloader: failed to migrate", key+":", err)
+				continue
+			}
+			db.driver.Delete(downloaderCollection, key)
+			n++
+		}
+	}
+	if n > 0 {
+		nlog.Infoln("downloader: migrated", n, "legacy record(s) into namespace", db.ns)
+	}
 }
 
 func (db *downloaderDB) errors(id string) (errs []TaskErrInfo, _ error) {
-	key := path.Join(downloaderErrors, id)
+	key := db.key(downloaderErrors, id)
 	if code, err := db.driver.Get(downloaderCollection, key, &errs); err != nil {
 		if !cos.IsNotExist(err) {
 			nlog.Errorln(err, code)
@@ -84,7 +138,7 @@ func (db *downloaderDB) persistError(id, objName, errMsg string) {
 	}
 	errMsgs = append(errMsgs, errInfo)
 
-	key := path.Join(downloaderErrors, id)
+	key := db.key(downloaderErrors, id)
 	if code, err := db.driver.Set(downloaderCollection, key, errMsgs); err != nil {
 		nlog.Errorln(err, code)
 		return
@@ -94,7 +148,7 @@ func (db *downloaderDB) persistError(id, objName, errMsg string) {
 }
 
 func (db *downloaderDB) tasks(id string) (tasks []TaskDlInfo, err error) {
-	key := path.Join(downloaderTasks, id)
+	key := db.key(downloaderTasks, id)
 	if code, err := db.driver.Get(downloaderCollection, key, &tasks); err != nil {
 		if !cos.IsNotExist(err) {
 			nlog.Errorln(err, code)
@@ -124,7 +178,7 @@ func (db *downloaderDB) persistTaskInfo(singleTask *singleTask) error {
 	}
 	persistedTasks = append(persistedTasks, singleTask.ToTaskDlInfo())
 
-	key := path.Join(downloaderTasks, id)
+	key := db.key(downloaderTasks, id)
 	if _, err := db.driver.Set(downloaderCollection, key, persistedTasks); err != nil {
 		return err
 	}
@@ -151,7 +205,7 @@ func (db *downloaderDB) flush(id string) error {
 			return err
 		}
 
-		key := path.Join(downloaderErrors, id)
+		key := db.key(downloaderErrors, id)
 		if code, err := db.driver.Set(downloaderCollection, key, errMsgs); err != nil {
 			nlog.Errorln(err, code)
 			return err
@@ -167,7 +221,7 @@ func (db *downloaderDB) flush(id string) error {
 			return err
 		}
 
-		key := path.Join(downloaderTasks, id)
+		key := db.key(downloaderTasks, id)
 		if code, err := db.driver.Set(downloaderCollection, key, persistedTasks); err != nil {
 			nlog.Errorln(err, code)
 			return err
@@ -181,9 +235,19 @@ func (db *downloaderDB) flush(id string) error {
 
 func (db *downloaderDB) delete(id string) {
 	db.mtx.Lock()
-	key := path.Join(downloaderErrors, id)
+	key := db.key(downloaderErrors, id)
 	db.driver.Delete(downloaderCollection, key)
-	key = path.Join(downloaderTasks, id)
+	key = db.key(downloaderTasks, id)
 	db.driver.Delete(downloaderCollection, key)
+	key = db.key(downloaderJobs, id)
+	db.driver.Delete(downloaderCollection, key)
+	db.mtx.Unlock()
+}
+
+// (see ResumeJob)
+func (db *downloaderDB) delErrors(id string) {
+	db.mtx.Lock()
+	db.driver.Delete(downloaderCollection, db.key(downloaderErrors, id))
+	db.errCache[id] = db.errCache[id][:0]
 	db.mtx.Unlock()
 }
@@ -0,0 +1,86 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestValidateNamespace(t *testing.T) {
+	tests := []struct {
+		ns    string
+		valid bool
+	}{
+		{"", true},
+		{"cluster-1", true},
+		{"ns_2.x", true},
+		{"errors", false},
+		{"tasks", false},
+		{"jobs", false},
+		{"a/b", false},
+		{"a b", false},
+	}
+	for _, test := range tests {
+		err := validateNamespace(test.ns)
+		tassert.Errorf(t, (err == nil) == test.valid, "%q: valid=%t, got %v", test.ns, test.valid, err)
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	driver, err := kvdb.NewBuntDB(filepath.Join(t.TempDir(), "dload.db"))
+	tassert.CheckFatal(t, err)
+	defer driver.Close()
+
+	// legacy (un-namespaced) layout
+	legacy := newDownloadDB(driver, "")
+	legacy.persistError("job", "obj", "failed")
+	legacy.taskInfoCache["job"] = []TaskDlInfo{{Name: "done"}}
+	tassert.CheckFatal(t, legacy.flush("job"))
+	tassert.Errorf(t, legacy.key(downloaderErrors, "job") == "errors/job", "unexpected legacy key")
+	rec := &JobRec{ID: "job", Body: Body{Type: TypeRange, RawMessage: []byte(`{"template":"o{1..3}"}`)}}
+	_, err = driver.Set(downloaderCollection, legacy.key(downloaderJobs, "job"), rec)
+	tassert.CheckFatal(t, err)
+
+	// migrated into the namespace upon startup
+	db1 := newDownloadDB(driver, "ns1")
+	tassert.Errorf(t, db1.key(downloaderErrors, "job") == "ns1/errors/job", "unexpected key %q",
+		db1.key(downloaderErrors, "job"))
+	errs, err := db1.getErrors("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(errs) == 1 && errs[0].Name == "obj", "expecting migrated error, got %+v", errs)
+	tasks, err := db1.getTasks("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(tasks) == 1 && tasks[0].Name == "done", "expecting migrated task, got %+v", tasks)
+
+	for _, kind := range []string{downloaderErrors, downloaderTasks, downloaderJobs} {
+		recs, _, err := driver.GetAll(downloaderCollection, kind+"/")
+		tassert.Errorf(t, len(recs) == 0 || cos.IsNotExist(err), "expecting no legacy %s, got %v (%v)", kind, recs, err)
+	}
+
+	// job records, to resume (see reconcile)
+	is := &infoStore{downloaderDB: db1, dljobs: make(map[string]*dljob)}
+	is.reconcile()
+	tassert.Fatalf(t, len(is.pending) == 1 && is.pending[0].ID == "job", "expecting migrated job to resume, got %+v", is.pending)
+	_, ok := is.pending[0].done["done"]
+	tassert.Errorf(t, ok, "expecting migrated finished item")
+
+	// namespaces do not see each other
+	db2 := newDownloadDB(driver, "ns2")
+	errs, err = db2.getErrors("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(errs) == 0, "ns2: expecting no errors, got %+v", errs)
+
+	db2.persistError("job", "obj2", "failed")
+	tassert.CheckFatal(t, db2.flush("job"))
+	db2.delete("job")
+	errs, err = db1.getErrors("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(errs) == 1, "ns1: expecting its own error intact, got %+v", errs)
+}
@@ -257,6 +257,11 @@ func (dr *DiffResolver) push(job jobif, d *dispatcher) {
 				dr.Stop()
 				return
 			}
+			if !job.Sync() && job.base().finished(obj.objName) {
+				g.store.incScheduled(job.ID())
+				g.store.incSkipped(job.ID(), SkipResumed)
+				continue
+			}
 			if !job.Sync() {
 				// When it is not a sync job, push LOM for a given object
 				// because we need to check if it exists.
@@ -38,7 +38,10 @@ type (
 		abortJob    map[string]*cos.StopCh // jobID -> abort job chan
 		workCh      chan jobif
 		stopCh      *cos.StopCh
+		drainCh     *cos.StopCh // closed upon graceful shutdown (see Xact.Shutdown)
 		config      *cmn.Config
+		prio        prioGate // job priorities (see prio.go)
+		draining    atomic.Bool
 	}
 
 	startupSema struct {
@@ -47,6 +50,7 @@ type (
 
 	global struct {
 		db    kvdb.Driver
+		ns    string // kvdb namespace (tenant)
 		store *infoStore
 
 		// Downloader selects one of the two clients (below) by the destination URL.
@@ -54,20 +58,31 @@ type (
 		clientH   *http.Client
 		clientTLS *http.Client
 
-		once sync.Once // newInfoStore upon the first execution
+		once sync.Once // newInfoStore upon the first execution (or PendingJobs)
 	}
 )
 
 var g global
 
-func Init(db kvdb.Driver, clientConf *cmn.ClientConf) {
+func initStore() {
+	g.once.Do(func() {
+		g.store = newInfoStore(g.db, g.ns)
+	})
+}
+
+// ns: optional kvdb namespace to segregate persisted job records (empty: legacy un-prefixed keys)
+func Init(db kvdb.Driver, ns string, clientConf *cmn.ClientConf) error {
 	g.clientH, g.clientTLS = newDloadClients(clientConf.TimeoutLong.D())
 
 	if db == nil { // unit tests only
-		return
+		return nil
 	}
-	g.db = db
+	if err := validateNamespace(ns); err != nil {
+		return err
+	}
+	g.db, g.ns = db, ns
 	xreg.RegNonBckXact(&factory{})
+	return nil
 }
 
 ////////////////
@@ -75,15 +90,18 @@ func Init(db kvdb.Driver, clientConf *cmn.ClientConf) {
 ////////////////
 
 func newDispatcher(xdl *Xact) *dispatcher {
-	return &dispatcher{
+	d := &dispatcher{
 		xdl:         xdl,
 		startupSema: startupSema{},
 		joggers:     make(map[string]*jogger, 8),
 		workCh:      make(chan jobif),
 		stopCh:      cos.NewStopCh(),
+		drainCh:     cos.NewStopCh(),
 		abortJob:    make(map[string]*cos.StopCh, 100),
 		config:      cmn.GCO.Get(),
 	}
+	d.prio.init()
+	return d
 }
 
 func (d *dispatcher) run() (err error) {
@@ -99,6 +117,8 @@ func (d *dispatcher) run() (err error) {
 	// allow other goroutines to run
 	d.startupSema.markStarted()
 
+	go d.watchdog()
+
 	nlog.Infoln(d.xdl.Name(), "started, cnt:", len(avail))
 mloop:
 	for {
@@ -153,11 +173,36 @@ func (d *dispatcher) stop() {
 	}
 }
 
+// log (once) tasks that are taking longer than expected
+func (d *dispatcher) watchdog() {
+	ticker := time.NewTicker(watchdogIval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, j := range d.joggers {
+				for _, task := range j.curTasks() {
+					started := task.started.Load()
+					if cos.IsTimeZero(started) {
+						continue
+					}
+					if elapsed := time.Since(started); elapsed > task.stuckAfter() && task.stuck.CAS(false, true) {
+						nlog.Warningln(d.xdl.Name(), "task", task.String(), "is taking too long:", elapsed,
+							"downloaded:", cos.ToSizeIEC(task.currentSize.Load(), 2))
+					}
+				}
+			}
+		case <-d.stopCh.Listen():
+			return
+		}
+	}
+}
+
 func (d *dispatcher) addJogger(mpath string) {
 	_, ok := d.joggers[mpath]
 	debug.Assert(!ok)
 	j := newJogger(d, mpath)
-	go j.jog()
+	j.run()
 	d.joggers[mpath] = j
 }
 
@@ -193,6 +238,10 @@ func (d *dispatcher) finish(job jobif) {
 func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 	defer d.finish(job)
 
+	// contend for priority until done dispatching (see prio.go)
+	d.prio.add(job.ID())
+	defer d.prio.remove(job.ID())
+
 	if aborted := d.checkAborted(); aborted || d.checkAbortedJob(job) {
 		return !aborted
 	}
@@ -236,8 +285,15 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 
 			g.store.incScheduled(job.ID())
 
+			if g.store.isCancelled(job.ID(), &obj) {
+				g.store.incCancelled(job.ID())
+				continue
+			}
 			if result.Action == DiffResolverSkip {
-				g.store.incSkipped(job.ID())
+				g.store.incSkipped(job.ID(), SkipUpToDate)
+				if result.Src != nil {
+					g.store.addLeaf(job.ID(), result.Src, nil)
+				}
 				continue
 			}
 
@@ -265,6 +321,11 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 				return ok
 			}
 			if !ok {
+				if d.draining.Load() {
+					// not aborted - interrupted (and resumable)
+					g.store.setInterrupted(job.ID())
+					return true
+				}
 				g.store.setAborted(job.ID())
 				return false
 			}
@@ -333,9 +394,23 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 		break
 	case <-d.jobAbortedCh(task.job.ID()).Listen():
 		return true, nil
+	case <-d.drainCh.Listen():
+		return false, nil
+	}
+
+	// Global pause, if any (see PauseAll).
+	if !gpause.wait(d.jobAbortedCh(task.job.ID()), d.stopCh, d.drainCh) {
+		task.job.throttler().release()
+		return !d.checkAborted(), nil
+	}
+
+	// Next, yield to higher-priority jobs, if any.
+	if !d.prio.enter(task.job.ID(), d.jobAbortedCh(task.job.ID()), d.stopCh, d.drainCh) {
+		task.job.throttler().release()
+		return !d.checkAborted(), nil
 	}
 
-	// Secondly, try to push the new task into queue.
+	// Finally, try to push the new task into queue.
 	select {
 	// TODO -- FIXME: currently, dispatcher halts if any given jogger is "full" but others available
 	case jogger.putCh(task) <- task:
@@ -346,6 +421,9 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 	case <-d.stopCh.Listen():
 		task.job.throttler().release()
 		return false, nil
+	case <-d.drainCh.Listen():
+		task.job.throttler().release()
+		return false, nil
 	}
 }
 
@@ -361,9 +439,17 @@ func (d *dispatcher) adminReq(req *request) (resp any, statusCode int, err error
 	case actStatus:
 		d.handleStatus(req)
 	case actAbort:
-		d.handleAbort(req)
+		if req.regex != nil {
+			d.handleAbortMatching(req)
+		} else {
+			d.handleAbort(req)
+		}
 	case actRemove:
 		d.handleRemove(req)
+	case actCancel:
+		d.handleCancelItem(req)
+	case actPrio:
+		d.handlePriority(req)
 	default:
 		debug.Assertf(false, "%v; %v", req, req.action)
 	}
@@ -389,14 +475,23 @@ func (d *dispatcher) handleAbort(req *request) {
 	if _, err := g.store.checkExists(req); err != nil {
 		return
 	}
-	d.jobAbortedCh(req.id).Close()
-	for _, j := range d.joggers {
-		j.abortJob(req.id)
-	}
+	d.abort(req.id)
 	g.store.setAborted(req.id)
 	req.okRsp(nil)
 }
 
+func (d *dispatcher) handleAbortMatching(req *request) {
+	n := g.store.abortMatching(req.regex, d.abort)
+	req.okRsp(&AbortResp{Aborted: n})
+}
+
+func (d *dispatcher) abort(id string) {
+	d.jobAbortedCh(id).Close()
+	for _, j := range d.joggers {
+		j.abortJob(id)
+	}
+}
+
 func (d *dispatcher) handleStatus(req *request) {
 	var (
 		finishedTasks []TaskDlInfo
@@ -423,19 +518,44 @@ func (d *dispatcher) handleStatus(req *request) {
 		sort.Sort(TaskErrByName(dlErrors))
 	}
 
-	req.okRsp(&StatusResp{
+	resp := &StatusResp{
 		Job:           dljob.clone(),
 		CurrentTasks:  currentTasks,
 		FinishedTasks: finishedTasks,
 		Errs:          dlErrors,
-	})
+	}
+	if req.inflight {
+		resp.Inflight = d.inflight(req.id)
+	}
+	req.okRsp(resp)
+}
+
+// currently dispatched (and not yet finished) items of a given job
+func (d *dispatcher) inflight(jobID string) []DlItemStatus {
+	items := make([]DlItemStatus, 0, len(d.joggers))
+	for mpath, j := range d.joggers {
+		for _, task := range j.getTasks(jobID) {
+			items = append(items, DlItemStatus{
+				Name:       task.obj.objName,
+				Link:       task.obj.link,
+				Mpath:      mpath,
+				Downloaded: task.currentSize.Load(),
+				Total:      task.totalSize.Load(),
+				StartTime:  task.started.Load(),
+				Stuck:      task.stuck.Load(),
+				Chunks:     int(task.numChunks.Load()),
+				ChunksDone: int(task.chunksDone.Load()),
+			})
+		}
+	}
+	sort.Slice(items, func(i, k int) bool { return items[i].StartTime.Before(items[k].StartTime) })
+	return items
 }
 
 func (d *dispatcher) activeTasks(reqID string) []TaskDlInfo {
 	currentTasks := make([]TaskDlInfo, 0, len(d.joggers))
 	for _, j := range d.joggers {
-		task := j.getTask(reqID)
-		if task != nil {
+		for _, task := range j.getTasks(reqID) {
 			currentTasks = append(currentTasks, task.ToTaskDlInfo())
 		}
 	}
@@ -455,6 +575,33 @@ func (d *dispatcher) pending(jobID string) bool {
 	return false
 }
 
+// stop dispatching and wait (bounded by ctx) for all queued and running tasks
+func (d *dispatcher) drain(ctx context.Context) error {
+	if !d.draining.CAS(false, true) {
+		return nil
+	}
+	d.drainCh.Close()
+	for {
+		var busy bool
+		for _, j := range d.joggers {
+			if j.busy() {
+				busy = true
+				break
+			}
+		}
+		if !busy {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.stopCh.Listen():
+			return nil
+		case <-time.After(cos.PollSleepShort):
+		}
+	}
+}
+
 // PRECONDITION: All tasks should be dispatched.
 func (d *dispatcher) waitFor(jobID string) {
 	for ; ; time.Sleep(time.Second) {
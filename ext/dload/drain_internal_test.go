@@ -0,0 +1,99 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func testDrainDispatcher() (*dispatcher, *jogger) {
+	d := &dispatcher{
+		joggers:  make(map[string]*jogger, 1),
+		stopCh:   cos.NewStopCh(),
+		drainCh:  cos.NewStopCh(),
+		abortJob: make(map[string]*cos.StopCh),
+	}
+	j := newJogger(d, "/tmp/mpath")
+	j.tasks = []*singleTask{{}} // (in-flight)
+	d.joggers[j.mpath] = j
+	return d, j
+}
+
+func TestDrain(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		d, _ := testDrainDispatcher()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := d.drain(ctx)
+		tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expecting deadline exceeded, got %v", err)
+		tassert.Errorf(t, d.draining.Load(), "expecting draining")
+		select {
+		case <-d.drainCh.Listen():
+		default:
+			t.Error("expecting drainCh closed")
+		}
+		// (once)
+		tassert.CheckError(t, d.drain(context.Background()))
+	})
+	t.Run("in-flight", func(t *testing.T) {
+		d, j := testDrainDispatcher()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			j.mtx.Lock()
+			j.tasks = j.tasks[:0]
+			j.mtx.Unlock()
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		started := time.Now()
+		tassert.CheckFatal(t, d.drain(ctx))
+		tassert.Errorf(t, time.Since(started) >= 100*time.Millisecond, "expecting drain to wait for in-flight task")
+	})
+}
+
+func TestInterrupt(t *testing.T) {
+	testStore(t, map[string]int{"run": 0, "abrt": 0, "fin": 0, "other": 0})
+	js := g.store.dljobs
+	for _, dljob := range js {
+		dljob.xid = "x1"
+	}
+	js["other"].xid = "x2"
+	js["abrt"].aborted.Store(true)
+	js["fin"].finishedTime.Store(time.Now())
+
+	// start request and (not yet flushed) finished item
+	driver := g.store.driver
+	rec := &JobRec{
+		ID:   "run",
+		Xid:  "x1",
+		Body: Body{Type: TypeRange, RawMessage: []byte(`{"template":"o{1..3}"}`)},
+	}
+	_, err := driver.Set(downloaderCollection, g.store.key(downloaderJobs, "run"), rec)
+	tassert.CheckFatal(t, err)
+	g.store.taskInfoCache["run"] = []TaskDlInfo{{Name: "o1"}}
+
+	ids := g.store.interrupt("x1")
+	tassert.Fatalf(t, len(ids) == 1 && ids[0] == "run", "expecting [run], got %v", ids)
+	tassert.Errorf(t, js["run"].interrupted.Load(), "expecting interrupted")
+	tassert.Errorf(t, !js["run"].aborted.Load(), "interrupted is not aborted")
+	for _, id := range []string{"abrt", "fin", "other"} {
+		tassert.Errorf(t, !js[id].interrupted.Load(), "%s: not expecting interrupted", id)
+	}
+	tassert.Errorf(t, len(g.store.taskInfoCache["run"]) == 0, "expecting flushed cache")
+
+	// restart
+	is := &infoStore{downloaderDB: newDownloadDB(driver, ""), dljobs: make(map[string]*dljob)}
+	is.reconcile()
+	tassert.Fatalf(t, len(is.pending) == 1 && is.pending[0].ID == "run", "expecting one job to resume, got %+v", is.pending)
+	tassert.Errorf(t, is.pending[0].Body.Type == TypeRange, "expecting start request to survive restart")
+	_, ok := is.pending[0].done["o1"]
+	tassert.Errorf(t, ok, "expecting finished item to survive restart")
+}
@@ -0,0 +1,101 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Per-job error sampling (see Job.ErrSample)
+// - when a source is broadly broken, jobs fail by the thousands: instead of logging
+//   each failure, keep the first and the last errSampleN distinct messages
+// - only the first errSampleN get logged (followed by a single "sampling" notice)
+// - Job.ErrorCnt remains exact; the complete list is still available via job status
+//   (see downloaderDB.persistError)
+
+const errSampleN = 8
+
+type errSample struct {
+	first []string           // in order of occurrence
+	last  [errSampleN]string // ring buffer: most recent, not in `first`
+	next  int                // ring position
+	cnt   int                // ring occupancy
+	mu    sync.Mutex
+}
+
+func (es *errSample) add(jobID, msg string) {
+	es.mu.Lock()
+	if es.has(msg) {
+		es.mu.Unlock()
+		return
+	}
+	if len(es.first) < errSampleN {
+		es.first = append(es.first, msg)
+		full := len(es.first) == errSampleN
+		es.mu.Unlock()
+
+		nlog.Errorln("download job", jobID+":", msg)
+		if full {
+			nlog.Warningln("download job", jobID+": sampling further errors (see job info)")
+		}
+		return
+	}
+	es.last[es.next] = msg
+	es.next = (es.next + 1) % errSampleN
+	es.cnt = min(es.cnt+1, errSampleN)
+	es.mu.Unlock()
+}
+
+func (es *errSample) has(msg string) bool {
+	for _, s := range es.first {
+		if s == msg {
+			return true
+		}
+	}
+	for i := range es.cnt {
+		if es.last[i] == msg {
+			return true
+		}
+	}
+	return false
+}
+
+// first, followed by last (oldest to newest)
+func (es *errSample) get() []string {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if len(es.first) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(es.first)+es.cnt)
+	out = append(out, es.first...)
+	start := (es.next - es.cnt + errSampleN) % errSampleN
+	for i := range es.cnt {
+		out = append(out, es.last[(start+i)%errSampleN])
+	}
+	return out
+}
+
+// (see Job.Aggregate)
+func mergeErrSample(a, b []string) []string {
+	for _, s := range b {
+		if len(a) >= 2*errSampleN {
+			break
+		}
+		var dup bool
+		for _, t := range a {
+			if s == t {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			a = append(a, s)
+		}
+	}
+	return a
+}
@@ -0,0 +1,83 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func testErrMsgs(from, to int) (msgs []string) {
+	for i := from; i < to; i++ {
+		msgs = append(msgs, "err-"+strconv.Itoa(i))
+	}
+	return msgs
+}
+
+func TestErrSample(t *testing.T) {
+	tests := []struct {
+		name     string
+		num      int
+		expected []string
+	}{
+		{"none", 0, nil},
+		{"few", 3, testErrMsgs(0, 3)},
+		{"first-full", errSampleN, testErrMsgs(0, errSampleN)},
+		{"ring-partial", errSampleN + 3, testErrMsgs(0, errSampleN+3)},
+		{"ring-full", 2 * errSampleN, testErrMsgs(0, 2*errSampleN)},
+		{"ring-wrapped", 5*errSampleN + 3, append(testErrMsgs(0, errSampleN), testErrMsgs(4*errSampleN+3, 5*errSampleN+3)...)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var es errSample
+			for _, msg := range testErrMsgs(0, test.num) {
+				es.add("job", msg)
+				es.add("job", msg) // (duplicates are ignored)
+			}
+			out := es.get()
+			tassert.Errorf(t, len(out) <= 2*errSampleN, "expecting at most %d, got %d", 2*errSampleN, len(out))
+			tassert.Errorf(t, slices.Equal(out, test.expected), "expecting %v, got %v", test.expected, out)
+		})
+	}
+}
+
+func TestErrSampleDedup(t *testing.T) {
+	var es errSample
+	for _, msg := range testErrMsgs(0, errSampleN+2) {
+		es.add("job", msg)
+	}
+	// already sampled - neither in `first` nor in the ring
+	es.add("job", "err-0")
+	es.add("job", "err-"+strconv.Itoa(errSampleN))
+	expected := testErrMsgs(0, errSampleN+2)
+	tassert.Errorf(t, slices.Equal(es.get(), expected), "expecting %v, got %v", expected, es.get())
+}
+
+func TestErrSampleJob(t *testing.T) {
+	const num = 100
+	testStore(t, map[string]int{"job": 0})
+	for i := range num {
+		g.store.incErrorCnt("job", "obj-"+strconv.Itoa(i), "failed")
+	}
+	job := g.store.dljobs["job"].clone()
+	tassert.Errorf(t, job.ErrorCnt == num, "expecting exact error count %d, got %d", num, job.ErrorCnt)
+	tassert.Errorf(t, len(job.ErrSample) == 2*errSampleN, "expecting %d sampled, got %d", 2*errSampleN, len(job.ErrSample))
+	tassert.Errorf(t, job.ErrSample[0] == "obj-0: failed", "expecting the first error first, got %q", job.ErrSample[0])
+	last := "obj-" + strconv.Itoa(num-1) + ": failed"
+	tassert.Errorf(t, job.ErrSample[len(job.ErrSample)-1] == last, "expecting the last error last, got %v", job.ErrSample)
+}
+
+func TestMergeErrSample(t *testing.T) {
+	a := testErrMsgs(0, 4)
+	a = mergeErrSample(a, testErrMsgs(2, 6))
+	tassert.Errorf(t, slices.Equal(a, testErrMsgs(0, 6)), "expecting deduplicated union, got %v", a)
+
+	a = mergeErrSample(a, testErrMsgs(100, 200))
+	tassert.Errorf(t, len(a) == 2*errSampleN, "expecting bounded to %d, got %d", 2*errSampleN, len(a))
+	tassert.Errorf(t, mergeErrSample(nil, nil) == nil, "expecting nil")
+}
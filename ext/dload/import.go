@@ -0,0 +1,285 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Import (TypeImport): download job from a listing of (source link, destination name) pairs.
+// Formats:
+// - csv:  one "link[,name[,range]]" per line; empty lines and lines starting with '#' are ignored,
+//         as is the optional "link,name" header
+// - json: a JSON array or one JSON object per line (NDJSON), each {"link": ..., "name": ..., "range": ...}
+// When the name is omitted, it is the base of the link's path (same as TypeMulti).
+// Malformed entries (bad link, no name, duplicate name, etc.) are reported by line number
+// (JSON array: by entry number); with ImportBody.SkipMalformed they are counted as job
+// errors instead of failing the job - each by exactly one target (the one that would
+// have owned the entry), so that the total adds up to the number of entries.
+
+const (
+	ImportCSV  = "csv"
+	ImportJSON = "json"
+
+	importMaxErrs = 10 // malformed entries to list when failing
+)
+
+type (
+	ImportBody struct {
+		Base
+		Spec          string `json:"spec"`                     // the listing itself (see above)
+		Format        string `json:"format,omitempty"`         // ImportCSV or ImportJSON (default: detect)
+		SkipMalformed bool   `json:"skip_malformed,omitempty"` // skip (and count as errors) rather than fail
+	}
+	ImportEntry struct {
+		Link  string `json:"link"`
+		Name  string `json:"name,omitempty"`
+		Range string `json:"range,omitempty"` // byte range (see SingleObj.Range)
+	}
+
+	importDlJob struct {
+		sliceDlJob
+		malformed []TaskErrInfo // this target's share
+		reported  bool
+	}
+)
+
+////////////////
+// ImportBody //
+////////////////
+
+func (b *ImportBody) Validate() error {
+	if strings.TrimSpace(b.Spec) == "" {
+		return errors.New("import: empty spec")
+	}
+	switch b.Format {
+	case "", ImportCSV, ImportJSON:
+	default:
+		return fmt.Errorf("import: invalid format %q (expecting %q or %q)", b.Format, ImportCSV, ImportJSON)
+	}
+	return b.Base.Validate()
+}
+
+func (b *ImportBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return "import -> " + b.Bck.Cname("")
+}
+
+func (b *ImportBody) String() string {
+	return fmt.Sprintf("bucket: %q", b.Bck.String())
+}
+
+// returns valid entries (name => link), their byte ranges (if any), and malformed ones (Name: "line N")
+func (b *ImportBody) parse() (objects cos.StrKVs, ranges map[string]*dlRange, malformed []TaskErrInfo, _ error) {
+	format := b.Format
+	spec := strings.TrimSpace(b.Spec)
+	if format == "" {
+		format = ImportCSV
+		if spec[0] == '[' || spec[0] == '{' {
+			format = ImportJSON
+		}
+	}
+	objects = make(cos.StrKVs, 64)
+	var (
+		lines = make(map[string]string, 64) // name => "line N" (duplicates)
+		add   = func(where string, en ImportEntry, err error) {
+			var rng *dlRange
+			if err == nil {
+				en.Name, err = en.validate()
+			}
+			if err == nil {
+				rng, err = parseDlRange(en.Range)
+			}
+			if err == nil {
+				if prev, ok := lines[en.Name]; ok {
+					err = fmt.Errorf("duplicate name %q (see %s)", en.Name, prev)
+				}
+			}
+			if err != nil {
+				malformed = append(malformed, TaskErrInfo{Name: where, Err: err.Error()})
+				return
+			}
+			lines[en.Name] = where
+			objects[en.Name] = en.Link
+			if rng != nil {
+				if ranges == nil {
+					ranges = make(map[string]*dlRange, 4)
+				}
+				ranges[en.Name] = rng
+			}
+		}
+	)
+	if format == ImportJSON && spec[0] == '[' {
+		var ens []jsoniter.RawMessage
+		if err := jsoniter.UnmarshalFromString(spec, &ens); err != nil {
+			return nil, nil, nil, fmt.Errorf("import: invalid JSON array: %v", err)
+		}
+		for i, raw := range ens {
+			var en ImportEntry
+			err := jsoniter.Unmarshal(raw, &en)
+			add("entry "+strconv.Itoa(i+1), en, err)
+		}
+	} else {
+		for i, ln := range strings.Split(b.Spec, "\n") {
+			ln = strings.TrimSpace(ln)
+			if ln == "" || ln[0] == '#' {
+				continue
+			}
+			var (
+				en  ImportEntry
+				err error
+			)
+			if format == ImportJSON {
+				err = jsoniter.UnmarshalFromString(ln, &en)
+			} else {
+				var skip bool
+				en, skip, err = parseCSVLine(ln, len(lines) == 0 && len(malformed) == 0)
+				if skip {
+					continue
+				}
+			}
+			add("line "+strconv.Itoa(i+1), en, err)
+		}
+	}
+	if len(malformed) > 0 && !b.SkipMalformed {
+		return nil, nil, nil, errMalformed(malformed)
+	}
+	if len(objects) == 0 && len(malformed) == 0 {
+		return nil, nil, nil, errors.New("import: no entries")
+	}
+	return objects, ranges, malformed, nil
+}
+
+func parseCSVLine(ln string, first bool) (en ImportEntry, header bool, err error) {
+	fields, err := csv.NewReader(strings.NewReader(ln)).Read()
+	if err != nil {
+		return en, false, err
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	if first && strings.EqualFold(fields[0], "link") {
+		return en, true, nil
+	}
+	switch len(fields) {
+	case 3:
+		en.Range = fields[2]
+		fallthrough
+	case 2:
+		en.Name = fields[1]
+		fallthrough
+	case 1:
+		en.Link = fields[0]
+	default:
+		err = fmt.Errorf("expecting \"link[,name[,range]]\", got %d fields", len(fields))
+	}
+	return en, false, err
+}
+
+func errMalformed(malformed []TaskErrInfo) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "import: %d malformed entr", len(malformed))
+	if len(malformed) == 1 {
+		sb.WriteString("y")
+	} else {
+		sb.WriteString("ies")
+	}
+	for i, m := range malformed {
+		if i == importMaxErrs {
+			fmt.Fprintf(&sb, "; ... (and %d more)", len(malformed)-i)
+			break
+		}
+		sb.WriteString("; ")
+		sb.WriteString(m.Name)
+		sb.WriteString(": ")
+		sb.WriteString(m.Err)
+	}
+	return errors.New(sb.String())
+}
+
+// returns destination name
+func (en *ImportEntry) validate() (string, error) {
+	if en.Link == "" {
+		return "", errors.New("missing link")
+	}
+	u, err := url.Parse(cmn.PrependProtocol(en.Link))
+	if err != nil {
+		return "", fmt.Errorf("invalid link %q: %v", en.Link, err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid link %q: no host", en.Link)
+	}
+	if en.Name != "" {
+		return en.Name, nil
+	}
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		return "", fmt.Errorf("failed to extract object name from %q", en.Link)
+	}
+	return name, nil
+}
+
+/////////////////
+// importDlJob //
+/////////////////
+
+func newImportDlJob(id string, bck *meta.Bck, payload *ImportBody, xdl *Xact) (*importDlJob, error) {
+	j := &importDlJob{}
+	if err := j.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
+	objects, ranges, malformed, err := payload.parse()
+	if err != nil {
+		return nil, err
+	}
+	if err := j.sliceDlJob.init(bck, objects, ranges); err != nil {
+		return nil, err
+	}
+	if len(malformed) > 0 {
+		var (
+			smap = core.T.Sowner().Get()
+			sid  = core.T.SID()
+		)
+		for _, m := range malformed {
+			if si, err := smap.HrwName2T(bck.MakeUname(m.Name)); err == nil && si.ID() == sid {
+				j.malformed = append(j.malformed, m)
+			}
+		}
+	}
+	return j, nil
+}
+
+func (j *importDlJob) String() string { return "import-" + j.baseDlJob.String() }
+
+func (j *importDlJob) Len() int { return len(j.objs) + len(j.malformed) }
+
+// upon the first batch (the job is registered by now), account for malformed entries
+func (j *importDlJob) genNext() ([]dlObj, bool, error) {
+	if !j.reported {
+		j.reported = true
+		if j.xdl != nil { // (not preflight)
+			for _, m := range j.malformed {
+				g.store.persistError(j.ID(), m.Name, m.Err)
+				g.store.incErrorCnt(j.ID(), m.Name, m.Err)
+			}
+		}
+	}
+	return j.sliceDlJob.genNext()
+}
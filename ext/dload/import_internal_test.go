@@ -0,0 +1,139 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestImportParse(t *testing.T) {
+	tests := []struct {
+		name, format, spec string
+		objects            map[string]string // name => link
+		ranges             map[string]string // name => range
+	}{
+		{
+			name: "csv",
+			spec: "link,name\n# comment\n\nhttp://host/a.tar\nhttp://host/x/b.tar, renamed/b.tar\n" +
+				`"http://host/c,1.tar",c.tar,0-99`,
+			objects: map[string]string{
+				"a.tar": "http://host/a.tar", "renamed/b.tar": "http://host/x/b.tar", "c.tar": "http://host/c,1.tar",
+			},
+			ranges: map[string]string{"c.tar": "0-99"},
+		},
+		{
+			name:    "json-array",
+			spec:    `[{"link": "http://host/a.tar"}, {"link": "host/b", "name": "b.tar", "range": "bytes=10-"}]`,
+			objects: map[string]string{"a.tar": "http://host/a.tar", "b.tar": "host/b"},
+			ranges:  map[string]string{"b.tar": "10-"},
+		},
+		{
+			name:    "ndjson",
+			spec:    "{\"link\": \"http://host/a.tar\"}\n# comment\n{\"link\": \"http://host/b\", \"name\": \"b.tar\"}",
+			objects: map[string]string{"a.tar": "http://host/a.tar", "b.tar": "http://host/b"},
+		},
+		{
+			name:    "explicit-csv",
+			format:  ImportCSV,
+			spec:    "http://host/a.tar",
+			objects: map[string]string{"a.tar": "http://host/a.tar"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := &ImportBody{Spec: test.spec, Format: test.format}
+			objects, ranges, malformed, err := b.parse()
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, len(malformed) == 0, "not expecting malformed: %+v", malformed)
+			tassert.Fatalf(t, len(objects) == len(test.objects), "expecting %v, got %v", test.objects, objects)
+			for name, link := range test.objects {
+				tassert.Errorf(t, objects[name] == link, "%s: expecting %q, got %q", name, link, objects[name])
+			}
+			tassert.Errorf(t, len(ranges) == len(test.ranges), "expecting ranges %v, got %v", test.ranges, ranges)
+			for name, rng := range test.ranges {
+				tassert.Errorf(t, ranges[name].String() == rng, "%s: expecting range %q, got %q", name, rng, ranges[name])
+			}
+		})
+	}
+}
+
+func TestImportMalformed(t *testing.T) {
+	const spec = "http://host/a.tar\n" + // line 1
+		"\n" +
+		"http://host/a.tar\n" + // line 3: duplicate
+		",name\n" + // line 4: missing link
+		"/no-host,x\n" + // line 5
+		"http://host/\n" + // line 6: no name
+		"http://host/b,b,99-1\n" + // line 7: bad range
+		"a,b,c,d\n" // line 8: too many fields
+
+	b := &ImportBody{Spec: spec}
+	_, _, _, err := b.parse()
+	tassert.Fatalf(t, err != nil, "expecting error")
+	for _, s := range []string{"6 malformed entries", "line 3: duplicate", "line 4: missing link", "line 5",
+		"line 6", "line 7", "line 8"} {
+		tassert.Errorf(t, strings.Contains(err.Error(), s), "expecting %q in %q", s, err)
+	}
+
+	b.SkipMalformed = true
+	objects, _, malformed, err := b.parse()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(objects) == 1 && len(malformed) == 6, "expecting 1 valid and 6 malformed, got %v, %+v",
+		objects, malformed)
+	tassert.Errorf(t, malformed[0].Name == "line 3", "expecting by line number, got %q", malformed[0].Name)
+
+	// JSON array: by entry number
+	b = &ImportBody{Spec: `[{"link": "http://host/a"}, {"name": "x"}, 5]`, SkipMalformed: true}
+	_, _, malformed, err = b.parse()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(malformed) == 2, "expecting 2 malformed, got %+v", malformed)
+	tassert.Errorf(t, malformed[0].Name == "entry 2" && malformed[1].Name == "entry 3", "unexpected %+v", malformed)
+
+	for _, spec := range []string{"[1, 2", "# comment only"} {
+		_, _, _, err = (&ImportBody{Spec: spec}).parse()
+		tassert.Errorf(t, err != nil, "%q: expecting error", spec)
+	}
+}
+
+func TestImportErrTruncated(t *testing.T) {
+	malformed := make([]TaskErrInfo, importMaxErrs+5)
+	for i := range malformed {
+		malformed[i] = TaskErrInfo{Name: "line " + strconv.Itoa(i+1), Err: "bad"}
+	}
+	s := errMalformed(malformed).Error()
+	tassert.Errorf(t, strings.Contains(s, "(and 5 more)"), "expecting truncated, got %q", s)
+	tassert.Errorf(t, !strings.Contains(s, "line "+strconv.Itoa(importMaxErrs+1)+":"), "expecting truncated, got %q", s)
+	s = errMalformed(malformed[:1]).Error()
+	tassert.Errorf(t, strings.HasPrefix(s, "import: 1 malformed entry;"), "unexpected %q", s)
+}
+
+func TestImportValidate(t *testing.T) {
+	bck := cmn.Bck{Name: "bck"}
+	tassert.CheckError(t, (&ImportBody{Base: Base{Bck: bck}, Spec: "http://host/a"}).Validate())
+	tassert.Errorf(t, (&ImportBody{Base: Base{Bck: bck}, Spec: " \n "}).Validate() != nil, "expecting empty spec error")
+	tassert.Errorf(t, (&ImportBody{Base: Base{Bck: bck}, Spec: "x", Format: "xml"}).Validate() != nil,
+		"expecting invalid format error")
+}
+
+// malformed entries are counted (by the owning target) as part of the job
+func TestImportJob(t *testing.T) {
+	bck := testTarget(t)
+	body := Body{
+		Type: TypeImport,
+		RawMessage: []byte(`{"bucket": {"name": "bck"}, "skip_malformed": true, ` +
+			`"spec": "http://host/a\nhttp://host/b\n,x"}`),
+	}
+	job, err := ParseStartRequest(bck, "job", body, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, job.Len() == 3, "expecting 2 valid + 1 malformed, got %d", job.Len())
+	objs, ok, err := job.genNext()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ok && len(objs) == 2, "expecting 2 objects, got %d", len(objs))
+}
@@ -7,29 +7,34 @@ package dload
 import (
 	"errors"
 	"net/http"
+	"regexp"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/hk"
 )
 
-// TODO: stored only in memory, should be persisted at some point (powercycle)
+// job stats are stored only in memory; start requests are persisted (see resume.go)
 type infoStore struct {
 	*downloaderDB
-	dljobs map[string]*dljob
+	dljobs  map[string]*dljob
+	pending []*JobRec // to resume (see PendingJobs)
 	sync.RWMutex
 }
 
-func newInfoStore(driver kvdb.Driver) *infoStore {
-	db := newDownloadDB(driver)
+func newInfoStore(driver kvdb.Driver, ns string) *infoStore {
+	db := newDownloadDB(driver, ns)
 	is := &infoStore{
 		downloaderDB: db,
 		dljobs:       make(map[string]*dljob),
 	}
+	is.reconcile()
 	hk.Reg("downloader"+hk.NameSuffix, is.housekeep, hk.DayInterval)
 	return is
 }
@@ -58,14 +63,62 @@ func (is *infoStore) getList(req *request) (jobs []*dljob) {
 	return
 }
 
+// same as getList but sorted by start time (and ID), to return the requested page
+// along with the total number of matching jobs
+func (is *infoStore) getListPage(req *request, offset, limit int) (page []*dljob, total int) {
+	jobs := is.getList(req)
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].less(jobs[j]) })
+	total = len(jobs)
+	if offset >= total {
+		return nil, total
+	}
+	return jobs[offset:min(offset+limit, total)], total
+}
+
+func (is *infoStore) aggregate() (a DlAggregate) {
+	is.RLock()
+	for _, dljob := range is.dljobs {
+		a.Jobs++
+		switch {
+		case dljob.aborted.Load():
+			a.Aborted++
+		case dljob.interrupted.Load():
+			a.Interrupted++
+		case _isRunning(dljob.finishedTime.Load()):
+			a.Running++
+		default:
+			a.Finished++
+		}
+		a.ScheduledCnt += int(dljob.scheduledCnt.Load())
+		a.FinishedCnt += int(dljob.finishedCnt.Load())
+		a.SkippedCnt += int(dljob.skippedCnt.Load())
+		a.Skipped = dljob.skippedByReason(a.Skipped)
+		a.ErrorCnt += int(dljob.errorCnt.Load())
+	}
+	is.RUnlock()
+	return a
+}
+
 func (is *infoStore) setJob(job jobif) (njob *dljob) {
 	njob = &dljob{
 		id:          job.ID(),
 		xid:         job.XactID(),
-		total:       job.Len(),
 		description: job.Description(),
+		nameTmpl:    job.NameTemplate(),
 		startedTime: time.Now(),
 	}
+	if p := job.Parent(); p != nil {
+		njob.pxid = p.ID()
+	}
+	njob.total.Store(int32(job.Len()))
+	njob.priority.Store(int32(job.Priority()))
+	if job.Manifest() {
+		njob.mft = &manifest{cksumType: job.CksumType()}
+	}
+	njob.pol = job.polite()
+	if wh := job.Webhook(); wh != nil {
+		njob.hook = newHook(wh)
+	}
 	is.Lock()
 	is.dljobs[job.ID()] = njob
 	is.Unlock()
@@ -76,25 +129,52 @@ func (is *infoStore) incFinished(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.finishedCnt.Inc()
+	subs.notify(dljob)
 }
 
-func (is *infoStore) incSkipped(id string) {
+func (is *infoStore) incSkipped(id string, reason SkipReason) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
+	dljob.skipped[reason].Inc()
 	dljob.skippedCnt.Inc()
 	dljob.finishedCnt.Inc()
+	subs.notify(dljob)
+}
+
+func (is *infoStore) incSpaceWait(id string, n int32) {
+	dljob, err := is.getJob(id)
+	if err != nil {
+		return // removed while waiting
+	}
+	dljob.spaceWait.Add(n)
+	subs.notify(dljob)
+}
+
+func (is *infoStore) addBytes(id string, size int64) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.bytes.Add(size)
 }
 
 func (is *infoStore) incScheduled(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.scheduledCnt.Inc()
+	subs.notify(dljob)
 }
 
-func (is *infoStore) incErrorCnt(id string) {
+func (is *infoStore) incErrorCnt(id, objName, errMsg string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.errorCnt.Inc()
+	dljob.errs.add(id, objName+": "+errMsg)
+	subs.notify(dljob)
+}
+
+func (is *infoStore) incTimeoutCnt(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.timeoutCnt.Inc()
 }
 
 func (is *infoStore) setAllDispatched(id string, dispatched bool) {
@@ -110,16 +190,87 @@ func (is *infoStore) markFinished(id string) (error, bool /*aborted*/) {
 		return err, false
 	}
 	dljob.finishedTime.Store(time.Now())
-	return dljob.valid(), dljob.aborted.Load()
+	aborted := dljob.aborted.Load()
+	if !dljob.interrupted.Load() {
+		is.delJobRec(id) // terminal
+	}
+	if dljob.mft != nil && !aborted && !dljob.interrupted.Load() {
+		dljob.mft.finalize(id)
+	}
+	subs.notify(dljob)
+	if dljob.hook != nil {
+		dljob.hook.fire(dljob) // including aborted (see setAborted)
+	}
+	return dljob.valid(), aborted
+}
+
+// Merkle manifest leaf (no-op unless enabled)
+func (is *infoStore) addLeaf(id string, lom *core.LOM, rng *dlRange) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	if dljob != nil && dljob.mft != nil {
+		dljob.mft.add(lom.ObjName, rng.String(), lom.Lsize(), lom.Checksum())
+	}
 }
 
 func (is *infoStore) setAborted(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.aborted.Store(true)
-	// NOTE: Don't set `FinishedTime` yet as we are not fully done.
+	// NOTE: Don't set `FinishedTime` (or fire the webhook) yet as we are not fully done.
 	//       The job now can be removed but there's no guarantee
 	//       that all tasks have been stopped and all resources were freed.
+	subs.notify(dljob) // (see WaitForTerminal)
+}
+
+// abort running jobs with matching descriptions; `abort` (dispatcher-side) runs
+// for each match outside the lock (no nesting with getJob and housekeep)
+func (is *infoStore) abortMatching(descRegex *regexp.Regexp, abort func(id string)) (n int) {
+	var ids []string
+	is.RLock()
+	for id, dljob := range is.dljobs {
+		if !dljob.aborted.Load() && _isRunning(dljob.finishedTime.Load()) && descRegex.MatchString(dljob.description) {
+			ids = append(ids, id)
+		}
+	}
+	is.RUnlock()
+	for _, id := range ids {
+		abort(id)
+		is.setAborted(id)
+	}
+	return len(ids)
+}
+
+func (is *infoStore) setInterrupted(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.interrupted.Store(true)
+	subs.notify(dljob)
+}
+
+// mark running jobs of a given xaction interrupted and flush their state
+// (so that they could be resumed upon restart - see Xact.Shutdown and resume.go)
+func (is *infoStore) interrupt(xid string) (ids []string) {
+	ids = is.running(xid)
+	for _, id := range ids {
+		is.setInterrupted(id)
+		if err := is.flush(id); err != nil {
+			nlog.Errorln("downloader: failed to flush job", id+":", err)
+		}
+	}
+	return ids
+}
+
+// jobs of a given xaction that are neither finished nor aborted
+func (is *infoStore) running(xid string) (ids []string) {
+	is.RLock()
+	for id, dljob := range is.dljobs {
+		if dljob.xid == xid && !dljob.aborted.Load() && _isRunning(dljob.finishedTime.Load()) {
+			ids = append(ids, id)
+		}
+	}
+	is.RUnlock()
+	return ids
 }
 
 func (is *infoStore) delJob(id string) {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
 const (
@@ -37,6 +39,7 @@ var (
 
 type (
 	dlObj struct {
+		rng        *dlRange // nil unless byte-range subsetting (see byterange.go)
 		objName    string
 		link       string
 		fromRemote bool
@@ -48,11 +51,23 @@ type (
 		Bck() *cmn.Bck
 		Description() string
 		Timeout() time.Duration
+		ItemTimeout() time.Duration
 		ActiveStats() (*StatusResp, error)
 		String() string
 		Notif() core.Notif // notifications
 		AddNotif(n core.Notif, job jobif)
 		Headers() http.Header
+		Priority() int
+		Manifest() bool
+		CksumType() string // destination bucket's (see manifest.go)
+		Webhook() *Webhook
+		NameTemplate() string
+		Parent() core.Xact // nil if none
+		MinFreePct() int
+		ChunkSize() int64 // zero: no chunked writes
+		VerifyOnly() bool
+		VerifyJob() string
+		refLeaf(objName string) (*MfLeaf, error) // (see verify.go)
 
 		// If total length (size) of download job is not known, -1 should be returned.
 		Len() int
@@ -70,9 +85,14 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// per source host (nil if not configured)
+		polite() *politeness
+
 		// job cleanup
 		cleanup()
 
+		base() *baseDlJob
+
 		// ETL methods
 		etlName() string
 		etlArgs() string
@@ -85,8 +105,23 @@ type (
 		id          string
 		description string
 		timeout     time.Duration
+		itemTimeout time.Duration
 		headers     http.Header
+		priority    int
+		manifest    bool
+		webhook     *Webhook
+		nt          *nameTmpl // destination naming (nil: default)
+		ntSrc       string
+		parent      core.Xact // see Base.ParentXactID
+		minFreePct  int
+		chunkSize   int64
+		verifyOnly  bool
+		verifyJob   string
+		refMft      refManifest // (see verify.go)
 		throt       throttler
+		pol         *politeness         // see polite.go
+		body        *Body               // start request (see resume.go)
+		done        map[string]struct{} // resumed: items finished prior to restart
 		_etlName    string
 		_etlArgs    string
 	}
@@ -116,6 +151,7 @@ type (
 		baseDlJob
 		prefix            string
 		suffix            string
+		regex             *regexp.Regexp // optional
 		continuationToken string
 		objs              []dlObj // objects' metas which are ready to be downloaded
 		sync              bool
@@ -125,16 +161,30 @@ type (
 	dljob struct {
 		id            string
 		xid           string
+		pxid          string // parent xaction, if any
 		description   string
 		startedTime   time.Time
 		finishedTime  atomic.Time
 		finishedCnt   atomic.Int32
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
+		skipped       [numSkipReasons]atomic.Int32 // by reason (adds up to skippedCnt)
 		errorCnt      atomic.Int32
-		total         int
+		errs          errSample // see Job.ErrSample
+		timeoutCnt    atomic.Int32
+		bytes         atomic.Int64 // downloaded (see DlProgress)
+		total         atomic.Int32 // negative if unknown; decremented upon cancel-item (see cancel.go)
+		cancelledCnt  atomic.Int32
+		cancelled     cancelSet    // items to drop (see cancel.go)
+		priority      atomic.Int32 // see prio.go
 		aborted       atomic.Bool
+		interrupted   atomic.Bool // see Xact.Shutdown
 		allDispatched atomic.Bool
+		spaceWait     atomic.Int32 // workers waiting for free space (see space.go)
+		mft           *manifest    // nil unless Base.Manifest
+		pol           *politeness  // nil unless Limits.PerHost or Limits.HostDelay
+		hook          *hook        // nil unless Base.Webhook
+		nameTmpl      string       // Base.NameTemplate
 	}
 )
 
@@ -142,35 +192,92 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits Limits, headers http.Header, xdl *Xact, etlName, etlArgs string) {
+func (j *baseDlJob) init(id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) (err error) {
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
+	limits := base.Limits
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= core.T.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
+	itd, _ := time.ParseDuration(base.ItemTimeout)
 	{
 		j.id = id
 		j.bck = bck
 		j.timeout = td
+		j.itemTimeout = itd
 		j.description = desc
-		j.headers = headers
+		j.headers = base.Headers
+		j.priority = base.Priority
+		j.manifest = base.Manifest
+		j.webhook = base.Webhook
+		j.minFreePct = base.MinFreePct
+		j.chunkSize = int64(base.ChunkSize)
+		j.verifyOnly = base.VerifyOnly
+		j.verifyJob = base.VerifyJob
 		j.throt.init(limits)
+		j.pol = newPoliteness(&limits)
 		j.xdl = xdl
-		j._etlName = etlName
-		j._etlArgs = etlArgs
+		j._etlName = base.ETLName
+		j._etlArgs = base.ETLArgs
+	}
+	if j.nt, err = newNameTmpl(base.NameTemplate); err != nil {
+		return err
+	}
+	j.ntSrc = base.NameTemplate
+	if base.ParentXactID != "" {
+		j.parent, err = parentXact(base.ParentXactID)
+	}
+	return err
+}
+
+// the parent must be running on this target; it gets credited with objects and bytes
+// downloaded by the job (in addition to the downloader xaction itself), so that
+// the parent's own stats (e.g. `ais show job <parent>`) reflect the download
+func parentXact(xid string) (core.Xact, error) {
+	xctn, err := xreg.GetXact(xid)
+	if err != nil {
+		return nil, err
 	}
+	if xctn == nil || !xctn.IsRunning() {
+		return nil, fmt.Errorf("parent xaction %q not found or not running on %s", xid, core.T)
+	}
+	if xctn.Kind() == apc.ActDownload {
+		return nil, fmt.Errorf("parent xaction %q cannot be (another) downloader", xid)
+	}
+	return xctn, nil
 }
 
-func (j *baseDlJob) ID() string             { return j.id }
-func (j *baseDlJob) XactID() string         { return j.xdl.ID() }
-func (j *baseDlJob) Bck() *cmn.Bck          { return j.bck.Bucket() }
-func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
-func (j *baseDlJob) Description() string    { return j.description }
-func (j *baseDlJob) Headers() http.Header   { return j.headers }
-func (j *baseDlJob) etlName() string        { return j._etlName }
-func (j *baseDlJob) etlArgs() string        { return j._etlArgs }
-func (*baseDlJob) Sync() bool               { return false }
+func (j *baseDlJob) ID() string                 { return j.id }
+func (j *baseDlJob) XactID() string             { return j.xdl.ID() }
+func (j *baseDlJob) Bck() *cmn.Bck              { return j.bck.Bucket() }
+func (j *baseDlJob) Timeout() time.Duration     { return j.timeout }
+func (j *baseDlJob) ItemTimeout() time.Duration { return j.itemTimeout }
+func (j *baseDlJob) Description() string        { return j.description }
+func (j *baseDlJob) Headers() http.Header       { return j.headers }
+func (j *baseDlJob) Priority() int              { return j.priority }
+func (j *baseDlJob) Manifest() bool             { return j.manifest }
+func (j *baseDlJob) Webhook() *Webhook          { return j.webhook }
+func (j *baseDlJob) NameTemplate() string       { return j.ntSrc }
+func (j *baseDlJob) Parent() core.Xact          { return j.parent }
+func (j *baseDlJob) MinFreePct() int            { return j.minFreePct }
+func (j *baseDlJob) ChunkSize() int64           { return j.chunkSize }
+func (j *baseDlJob) VerifyOnly() bool           { return j.verifyOnly }
+func (j *baseDlJob) CksumType() string          { return j.bck.CksumConf().Type }
+func (j *baseDlJob) VerifyJob() string          { return j.verifyJob }
+func (j *baseDlJob) etlName() string            { return j._etlName }
+func (j *baseDlJob) etlArgs() string            { return j._etlArgs }
+func (*baseDlJob) Sync() bool                   { return false }
+
+// loaded upon the first call
+func (j *baseDlJob) refLeaf(objName string) (*MfLeaf, error) {
+	rm := &j.refMft
+	rm.once.Do(func() { rm.load(j.verifyJob) })
+	if rm.err != nil {
+		return nil, rm.err
+	}
+	return rm.leaves[objName], nil
+}
 
 func (j *baseDlJob) String() (s string) {
 	s = fmt.Sprintf("dl-job[%s]-%s", j.ID(), j.Bck())
@@ -195,7 +302,7 @@ func (j *baseDlJob) AddNotif(n core.Notif, job jobif) {
 }
 
 func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
-	resp, _, err := j.xdl.JobStatus(j.ID(), true /*onlyActive*/)
+	resp, _, err := j.xdl.JobStatus(j.ID(), true /*onlyActive*/, false /*inflight*/)
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +311,8 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (j *baseDlJob) polite() *politeness   { return j.pol }
+func (j *baseDlJob) base() *baseDlJob      { return j }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
@@ -212,6 +321,9 @@ func (j *baseDlJob) cleanup() {
 	if err != nil {
 		nlog.Errorln(j.String()+":", err, aborted)
 	}
+	if dljob, e := g.store.getJob(j.ID()); e == nil && dljob.interrupted.Load() && err == nil {
+		err = errInterrupted
+	}
 	g.store.flush(j.ID())
 	nl.OnFinished(j.Notif(), err, aborted)
 }
@@ -220,8 +332,9 @@ func (j *baseDlJob) cleanup() {
 // sliceDlJob -- multiDlJob -- singleDlJob
 //
 
-func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs) error {
-	objs, err := buildDlObjs(bck, objects)
+// ranges (optional): by (original) name
+func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs, ranges map[string]*dlRange) error {
+	objs, err := buildDlObjs(bck, j.nt, objects, ranges)
 	if err != nil {
 		return err
 	}
@@ -250,12 +363,14 @@ func newMultiDlJob(id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj
 	var objs cos.StrKVs
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl, payload.ETLName, payload.ETLArgs)
+	if err = mj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
 	}
-	err = mj.sliceDlJob.init(bck, objs)
+	err = mj.sliceDlJob.init(bck, objs, nil)
 	return
 }
 
@@ -265,12 +380,22 @@ func newSingleDlJob(id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (s
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl, payload.ETLName, payload.ETLArgs)
+	if err = sj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
 	}
-	err = sj.sliceDlJob.init(bck, objs)
+	var ranges map[string]*dlRange
+	if payload.Range != "" {
+		rng, err := parseDlRange(payload.Range)
+		if err != nil {
+			return nil, err
+		}
+		ranges = map[string]*dlRange{payload.ObjName: rng}
+	}
+	err = sj.sliceDlJob.init(bck, objs, ranges)
 	return
 }
 
@@ -288,9 +413,10 @@ func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl, payload.ETLName, payload.ETLArgs)
-
-	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck); err != nil {
+	if err = rj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
+	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck, rj.nt); err != nil {
 		return nil, err
 	}
 	rj.pt.InitIter()
@@ -328,7 +454,7 @@ func (j *rangeDlJob) getNextObjs() error {
 			break
 		}
 		name := path.Join(j.dir, path.Base(link))
-		obj, err := makeDlObj(smap, sid, j.bck, name, link)
+		obj, err := makeDlObj(smap, sid, j.bck, j.nt, name, link)
 		if err != nil {
 			if err == errInvalidTarget {
 				continue
@@ -351,24 +477,38 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, nil, xdl, payload.ETLName, payload.ETLArgs)
+	if err = bj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 	{
+		bj.headers = nil // n/a
 		bj.sync = payload.Sync
 		bj.prefix = payload.Prefix
 		bj.suffix = payload.Suffix
 	}
+	if payload.Regex != "" {
+		bj.regex, err = regexp.Compile(payload.Regex)
+	}
 	return
 }
 
+// the total is unknown until the (remote) listing completes - see also Job.TotalCnt
 func (*backendDlJob) Len() int     { return -1 }
 func (j *backendDlJob) Sync() bool { return j.sync }
 
 func (j *backendDlJob) String() (s string) {
-	return fmt.Sprintf("backend-%s-%s-%s", &j.baseDlJob, j.prefix, j.suffix)
+	s = fmt.Sprintf("backend-%s-%s-%s", &j.baseDlJob, j.prefix, j.suffix)
+	if j.regex != nil {
+		s += "-" + j.regex.String()
+	}
+	return s
 }
 
 func (j *backendDlJob) checkObj(objName string) bool {
-	return strings.HasPrefix(objName, j.prefix) && strings.HasSuffix(objName, j.suffix)
+	if !strings.HasPrefix(objName, j.prefix) || !strings.HasSuffix(objName, j.suffix) {
+		return false
+	}
+	return j.regex == nil || j.regex.MatchString(objName)
 }
 
 func (j *backendDlJob) genNext() (objs []dlObj, ok bool, err error) {
@@ -405,7 +545,7 @@ func (j *backendDlJob) getNextObjs() error {
 			if !j.checkObj(entry.Name) {
 				continue
 			}
-			obj, err := makeDlObj(smap, sid, j.bck, entry.Name, "")
+			obj, err := makeDlObj(smap, sid, j.bck, j.nt, entry.Name, "")
 			if err != nil {
 				if err == errInvalidTarget {
 					continue
@@ -427,25 +567,64 @@ func (j *backendDlJob) getNextObjs() error {
 ///////////
 
 func (j *dljob) clone() Job {
+	var (
+		root string
+		hook *WebhookStatus
+	)
+	if j.mft != nil {
+		root = j.mft.getRoot()
+	}
+	if j.hook != nil {
+		hook = j.hook.getStatus()
+	}
 	return Job{
+		MerkleRoot:    root,
+		Webhook:       hook,
+		Hosts:         j.pol.stats(),
+		NameTemplate:  j.nameTmpl,
 		ID:            j.id,
 		XactID:        j.xid,
+		ParentXactID:  j.pxid,
 		Description:   j.description,
 		FinishedCnt:   int(j.finishedCnt.Load()),
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
+		Skipped:       j.skippedByReason(nil),
 		ErrorCnt:      int(j.errorCnt.Load()),
-		Total:         j.total,
+		ErrSample:     j.errs.get(),
+		TimeoutCnt:    int(j.timeoutCnt.Load()),
+		CancelledCnt:  int(j.cancelledCnt.Load()),
+		Total:         int(j.total.Load()),
+		Priority:      int(j.priority.Load()),
 		AllDispatched: j.allDispatched.Load(),
 		Aborted:       j.aborted.Load(),
+		Interrupted:   j.interrupted.Load(),
+		SpaceWait:     j.spaceWait.Load() > 0,
 		StartedTime:   j.startedTime,
 		FinishedTime:  j.finishedTime.Load(),
 	}
 }
 
+func (j *dljob) less(other *dljob) bool {
+	return lessByStart(j.startedTime, other.startedTime, j.id, other.id)
+}
+
+// adds non-zero per-reason counters to `m` (allocated upon the first)
+func (j *dljob) skippedByReason(m map[string]int) map[string]int {
+	for r := range j.skipped {
+		if n := int(j.skipped[r].Load()); n > 0 {
+			if m == nil {
+				m = make(map[string]int, numSkipReasons)
+			}
+			m[SkipReason(r).String()] += n
+		}
+	}
+	return m
+}
+
 // Used for debugging purposes to ensure integrity of the struct.
 func (j *dljob) valid() (err error) {
-	if j.aborted.Load() {
+	if j.aborted.Load() || j.interrupted.Load() {
 		return
 	}
 	if !j.allDispatched.Load() {
@@ -0,0 +1,49 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func TestParentXact(t *testing.T) {
+	bck := testTarget(t)
+	xreg.TestReset()
+
+	_, err := parentXact("not a uuid")
+	tassert.Errorf(t, err != nil, "expecting invalid ID error")
+	_, err = parentXact(cos.GenUUID())
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "not found"), "expecting not-found, got %v", err)
+
+	// (start request)
+	body := Body{
+		Type:       TypeMulti,
+		RawMessage: []byte(`{"bucket": {"name": "bck"}, "objects": ["http://host/a"], "parent_xid": "` + cos.GenUUID() + `"}`),
+	}
+	_, err = ParseStartRequest(bck, "job", body, nil)
+	tassert.Errorf(t, err != nil, "expecting parent not found")
+
+	// job info references the parent
+	testStore(t, nil)
+	var (
+		xdl    = &Xact{}
+		parent = mock.NewXact(apc.ActPrefetchObjects)
+	)
+	xdl.InitBase(cos.GenUUID(), apc.ActDownload, nil)
+	job := &sliceDlJob{baseDlJob: baseDlJob{id: "job", xdl: xdl, parent: parent}}
+	dljob := g.store.setJob(job).clone()
+	tassert.Errorf(t, dljob.XactID == xdl.ID() && dljob.ParentXactID == parent.ID(), "expecting xaction %s, parent %s, got %+v",
+		xdl.ID(), parent.ID(), dljob)
+
+	job = &sliceDlJob{baseDlJob: baseDlJob{id: "no-parent", xdl: xdl}}
+	tassert.Errorf(t, g.store.setJob(job).clone().ParentXactID == "", "not expecting parent")
+}
@@ -5,9 +5,12 @@
 package dload
 
 import (
+	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -15,8 +18,16 @@ import (
 
 const queueChSize = 1000
 
+// concurrent download workers per mountpath (see SetWorkers)
+const (
+	dfltWorkers = 1
+	maxWorkers  = 64
+)
+
+var numWorkers = func() (n atomic.Int32) { n.Store(dfltWorkers); return }()
+
 type (
-	queueEntry = map[string]struct{}
+	queueEntry = map[string]*singleTask // request uid -> task (see cancel.go)
 
 	queue struct {
 		ch chan *singleTask      // for pending downloads
@@ -28,29 +39,95 @@ type (
 	// corresponding to the jogger's mpath are forwarded to the jogger. Joggers
 	// exist in the Downloader's jogger member variable, and run only when there
 	// are dlTasks.
+	// Each jogger runs up to `numWorkers` workers sharing the same queue.
 	jogger struct {
 		mpath       string
 		terminateCh cos.StopCh // synchronizes termination
 		parent      *dispatcher
 		q           *queue
-		task        *singleTask // currently running download task
+		tasks       []*singleTask // currently running download tasks (one per busy worker)
+		live        int           // number of running workers
 		mtx         sync.Mutex
 		stopAgent   bool
 	}
 )
 
+// SetWorkers sets the number of concurrent download workers per mountpath.
+// Takes effect at runtime: joggers grow upon the next queued task, and shrink
+// as (excess) workers finish their current tasks - in-flight downloads are never dropped.
+func SetWorkers(n int) error {
+	if n < 1 || n > maxWorkers {
+		return fmt.Errorf("invalid number of download workers per mountpath %d (expecting 1 to %d)", n, maxWorkers)
+	}
+	if prev := numWorkers.Swap(int32(n)); prev != int32(n) {
+		nlog.Infoln("downloader: workers per mountpath", prev, "=>", n)
+	}
+	return nil
+}
+
+func Workers() int { return int(numWorkers.Load()) }
+
 func newJogger(d *dispatcher, mpath string) (j *jogger) {
 	j = &jogger{mpath: mpath, parent: d, q: newQueue()}
 	j.terminateCh.Init()
 	return
 }
 
+func (j *jogger) run() {
+	j.mtx.Lock()
+	j.live = 1
+	j.mtx.Unlock()
+	go j.jog()
+}
+
+// spawn more workers, if need be
+func (j *jogger) grow() {
+	want := int(numWorkers.Load())
+	j.mtx.Lock()
+	for ; j.live < want && !j.stopAgent; j.live++ {
+		go j.jog()
+	}
+	j.mtx.Unlock()
+}
+
+// exit if there are more workers than wanted (never the last one)
+func (j *jogger) shrink() bool {
+	want := int(numWorkers.Load())
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if j.live > want && j.live > 1 {
+		j.live--
+		return true
+	}
+	return false
+}
+
+// the last worker to exit (upon stop) cleans up and signals termination
+func (j *jogger) exit() {
+	j.mtx.Lock()
+	j.live--
+	last := j.live == 0
+	j.mtx.Unlock()
+	if last {
+		j.q.cleanup()
+		j.terminateCh.Close()
+	}
+}
+
 func (j *jogger) jog() {
 	for {
+		if j.shrink() {
+			return
+		}
 		t := j.q.get()
 		if t == nil {
 			break
 		}
+		j.grow()
+		// global pause (see PauseAll); upon abort or stop, fall through
+		gpause.wait(j.parent.jobAbortedCh(t.jobID()), j.parent.stopCh, j.parent.drainCh)
+		// ditto: low disk space (see Base.MinFreePct)
+		j.waitSpace(t)
 
 		j.mtx.Lock()
 		// Check if the task exists to ensure that the job wasn't removed while
@@ -73,8 +150,8 @@ func (j *jogger) jog() {
 			continue
 		}
 
-		j.task = t
-		j.task.init()
+		j.tasks = append(j.tasks, t)
+		t.init()
 		j.mtx.Unlock()
 
 		// do
@@ -88,16 +165,15 @@ func (j *jogger) jog() {
 		t.job.throttler().release()
 
 		j.mtx.Lock()
-		j.task.persist()
-		j.task = nil
+		t.persist()
+		j.tasks = slices.DeleteFunc(j.tasks, func(rt *singleTask) bool { return rt == t })
 		j.mtx.Unlock()
 		if j.q.del(t) {
 			j.parent.xdl.DecPending()
 		}
 	}
 
-	j.q.cleanup()
-	j.terminateCh.Close()
+	j.exit()
 }
 
 // stop terminates the jogger and waits for it to finish.
@@ -106,8 +182,8 @@ func (j *jogger) stop() {
 
 	j.mtx.Lock()
 	j.stopAgent = true
-	if j.task != nil {
-		j.task.cancel() // Stops running task (cancels download).
+	for _, t := range j.tasks {
+		t.cancel() // Stops running task (cancels download).
 	}
 	j.mtx.Unlock()
 	j.q.close()
@@ -126,19 +202,30 @@ func (j *jogger) putCh(t *singleTask) chan<- *singleTask {
 	return ch
 }
 
-func (j *jogger) getTask(jobID string) (task *singleTask) {
+// running tasks of a given job
+func (j *jogger) getTasks(jobID string) (tasks []*singleTask) {
 	j.mtx.Lock()
-	if j.task != nil && j.task.jobID() == jobID {
-		task = j.task
+	for _, t := range j.tasks {
+		if t.jobID() == jobID {
+			tasks = append(tasks, t)
+		}
 	}
 	j.mtx.Unlock()
-	return task
+	return tasks
+}
+
+// currently running tasks, if any
+func (j *jogger) curTasks() (tasks []*singleTask) {
+	j.mtx.Lock()
+	tasks = slices.Clone(j.tasks)
+	j.mtx.Unlock()
+	return tasks
 }
 
 func (j *jogger) abortJob(id string) {
 	var (
-		task *singleTask
-		cnt  int
+		tasks []*singleTask
+		cnt   int
 	)
 	j.mtx.Lock()
 
@@ -148,16 +235,20 @@ func (j *jogger) abortJob(id string) {
 	if cnt > 0 {
 		j.parent.xdl.SubPending(cnt)
 	}
-	if j.task != nil && j.task.jobID() == id {
-		task = j.task
+	for _, t := range j.tasks {
 		// iff the task belongs to the specified job
-		j.task.cancel()
+		if t.jobID() == id {
+			t.cancel()
+			tasks = append(tasks, t)
+		}
 	}
 
 	j.mtx.Unlock()
 
-	if task != nil && cmn.Rom.V(4, cos.ModDload) /*verbose*/ {
-		nlog.Infof("%s: abort-job[%s, mpath=%s], task=%s", core.T.String(), id, j.mpath, j.task.String())
+	if cmn.Rom.V(4, cos.ModDload) /*verbose*/ {
+		for _, t := range tasks {
+			nlog.Infof("%s: abort-job[%s, mpath=%s], task=%s", core.T.String(), id, j.mpath, t.String())
+		}
 	}
 }
 
@@ -171,8 +262,21 @@ func (j *jogger) taskExists(t *singleTask) (exists bool) {
 // Returns true if there is any pending task for a given job (either running or in queue),
 // false otherwise.
 func (j *jogger) pending(id string) bool {
-	task := j.getTask(id)
-	return task != nil || j.q.pending(id)
+	return len(j.getTasks(id)) > 0 || j.q.pending(id)
+}
+
+// any task at all, running or queued
+func (j *jogger) busy() bool {
+	j.mtx.Lock()
+	running := len(j.tasks) > 0
+	j.mtx.Unlock()
+	if running {
+		return true
+	}
+	j.q.mu.RLock()
+	queued := len(j.q.m) > 0
+	j.q.mu.RUnlock()
+	return queued
 }
 
 func newQueue() *queue {
@@ -189,7 +293,7 @@ func (q *queue) putCh(t *singleTask) (ok bool, ch chan<- *singleTask) {
 		// hence return channel which immediately accepts and omits the task.
 		return false, make(chan *singleTask, 1)
 	}
-	q.putToSet(t.jobID(), t.uid())
+	q.putToSet(t)
 	return true, q.ch
 }
 
@@ -244,11 +348,12 @@ func (q *queue) pending(jobID string) (exists bool) {
 }
 
 // PRECONDITION: `q.Lock()` must be taken.
-func (q *queue) putToSet(jobID, requestUID string) {
+func (q *queue) putToSet(t *singleTask) {
+	jobID := t.jobID()
 	if _, ok := q.m[jobID]; !ok {
 		q.m[jobID] = make(queueEntry)
 	}
-	q.m[jobID][requestUID] = struct{}{}
+	q.m[jobID][t.uid()] = t
 }
 
 // PRECONDITION: `q.Lock()` must be taken.
@@ -0,0 +1,81 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSetWorkers(t *testing.T) {
+	defer SetWorkers(dfltWorkers)
+	for n, valid := range map[int]bool{0: false, -1: false, maxWorkers + 1: false, 1: true, maxWorkers: true, 8: true} {
+		err := SetWorkers(n)
+		tassert.Errorf(t, (err == nil) == valid, "%d: valid=%t, got %v", n, valid, err)
+		if valid {
+			tassert.Errorf(t, Workers() == n, "expecting %d workers, got %d", n, Workers())
+		}
+	}
+}
+
+func TestJoggerGrowShrink(t *testing.T) {
+	const jobID = "job"
+	defer SetWorkers(dfltWorkers)
+	var (
+		d = &dispatcher{
+			stopCh:   cos.NewStopCh(),
+			drainCh:  cos.NewStopCh(),
+			abortJob: map[string]*cos.StopCh{jobID: cos.NewStopCh()},
+		}
+		j   = newJogger(d, "/tmp/mpath")
+		job = &sliceDlJob{baseDlJob: baseDlJob{id: jobID}}
+		cnt int
+	)
+	live := func() int {
+		j.mtx.Lock()
+		defer j.mtx.Unlock()
+		return j.live
+	}
+	// (tasks that are no longer pending get skipped - see TestPauseAll)
+	put := func(n int) {
+		for range n {
+			j.q.ch <- &singleTask{job: job, obj: dlObj{objName: "o" + strconv.Itoa(cnt)}}
+			cnt++
+		}
+	}
+	waitLive := func(expected int, what string) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for live() != expected && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		tassert.Fatalf(t, live() == expected, "%s: expecting %d workers, got %d", what, expected, live())
+	}
+
+	j.run()
+	waitLive(1, "initially")
+
+	// grows upon the next queued task
+	tassert.CheckFatal(t, SetWorkers(4))
+	tassert.Errorf(t, live() == 1, "not expecting to grow while idle")
+	put(1)
+	waitLive(4, "grow")
+
+	// shrinks as workers finish their tasks - never below one
+	tassert.CheckFatal(t, SetWorkers(1))
+	put(10)
+	waitLive(1, "shrink")
+	put(10)
+	time.Sleep(50 * time.Millisecond)
+	tassert.Errorf(t, live() == 1, "expecting the last worker to stay, got %d", live())
+
+	d.stopCh.Close()
+	j.stop()
+	tassert.Errorf(t, live() == 0, "expecting no workers upon stop, got %d", live())
+}
@@ -28,3 +28,31 @@ ex:
 	rsp := req.response
 	return rsp.value, rsp.statusCode, rsp.err
 }
+
+// paginated ListJobs (see AdminBody.Limit)
+func ListJobsPage(regex *regexp.Regexp, onlyActive bool, offset, limit int) (any, int, error) {
+	var (
+		jobs []*dljob
+		page = JobPage{Jobs: JobInfos{}}
+		req  = &request{action: actList, regex: regex, onlyActive: onlyActive}
+	)
+	if g.store != nil {
+		jobs, page.Total = g.store.getListPage(req, offset, limit)
+	}
+	for _, dljob := range jobs {
+		job := dljob.clone()
+		page.Jobs = append(page.Jobs, &job)
+	}
+	req.okRsp(page)
+	rsp := req.response
+	return rsp.value, rsp.statusCode, rsp.err
+}
+
+// Summary returns totals across all download jobs (see also: DlAggregate.Merge)
+func Summary() (a DlAggregate) {
+	if g.store != nil {
+		a = g.store.aggregate()
+	}
+	a.Paused = IsPaused()
+	return a
+}
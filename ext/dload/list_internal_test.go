@@ -0,0 +1,162 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSummary(t *testing.T) {
+	testStore(t, map[string]int{"run": 0, "fin": 0, "abrt": 0, "intr": 0})
+	js := g.store.dljobs
+	js["run"].scheduledCnt.Store(10)
+	js["run"].finishedCnt.Store(4)
+	js["run"].errorCnt.Store(1)
+	js["fin"].scheduledCnt.Store(5)
+	js["fin"].finishedCnt.Store(5)
+	js["fin"].finishedTime.Store(time.Now())
+	js["abrt"].aborted.Store(true)
+	js["abrt"].finishedTime.Store(time.Now())
+	js["intr"].interrupted.Store(true)
+
+	a := Summary()
+	tassert.Errorf(t, a.Jobs == 4 && a.Running == 1 && a.Finished == 1 && a.Aborted == 1 && a.Interrupted == 1,
+		"unexpected job counts: %+v", a)
+	tassert.Errorf(t, a.ScheduledCnt == 15 && a.FinishedCnt == 9 && a.ErrorCnt == 1, "unexpected counters: %+v", a)
+	tassert.Errorf(t, !a.Paused, "not expecting paused")
+
+	PauseAll()
+	a = Summary()
+	ResumeAll()
+	tassert.Errorf(t, a.Paused, "expecting paused")
+
+	// another target: counters add up while job counts don't (every job runs on all targets)
+	b := DlAggregate{Jobs: 4, Running: 2, Finished: 1, ScheduledCnt: 3, FinishedCnt: 1, ErrorCnt: 2}
+	a.Merge(&b)
+	tassert.Errorf(t, a.Jobs == 4 && a.Running == 2 && a.Finished == 1 && a.Aborted == 1, "unexpected job counts: %+v", a)
+	tassert.Errorf(t, a.ScheduledCnt == 18 && a.FinishedCnt == 10 && a.ErrorCnt == 3 && a.Paused,
+		"unexpected merged counters: %+v", a)
+}
+
+func TestSkippedByReason(t *testing.T) {
+	testStore(t, map[string]int{"a": 0, "b": 0})
+	for range 3 {
+		g.store.incSkipped("a", SkipUpToDate)
+	}
+	g.store.incSkipped("a", SkipNotModified)
+	g.store.incSkipped("b", SkipResumed)
+
+	job := g.store.dljobs["a"].clone()
+	tassert.Errorf(t, job.SkippedCnt == 4 && job.FinishedCnt == 4, "expecting 4 skipped (and finished), got %+v", job)
+	tassert.Errorf(t, len(job.Skipped) == 2 && job.Skipped["up-to-date"] == 3 && job.Skipped["not-modified"] == 1,
+		"unexpected breakdown %v", job.Skipped)
+	tassert.Errorf(t, g.store.dljobs["b"].clone().Skipped["resumed"] == 1, "expecting 1 resumed")
+
+	// summary: all jobs
+	a := Summary()
+	tassert.Errorf(t, a.SkippedCnt == 5 && len(a.Skipped) == 3 && a.Skipped["up-to-date"] == 3, "unexpected %+v", a)
+
+	// across targets: the breakdown adds up, as does the total
+	job.Aggregate(&Job{SkippedCnt: 2, Skipped: map[string]int{"up-to-date": 1, "resumed": 1}})
+	tassert.Errorf(t, job.SkippedCnt == 6 && job.Skipped["up-to-date"] == 4 && job.Skipped["resumed"] == 1,
+		"unexpected aggregated %+v", job)
+	var total int
+	for _, n := range job.Skipped {
+		total += n
+	}
+	tassert.Errorf(t, total == job.SkippedCnt, "breakdown (%d) must add up to the total (%d)", total, job.SkippedCnt)
+
+	// no skips, no map
+	testStore(t, map[string]int{"c": 0})
+	tassert.Errorf(t, g.store.dljobs["c"].clone().Skipped == nil, "expecting nil breakdown")
+}
+
+func TestListJobsPage(t *testing.T) {
+	const num = 7
+	ids := make(map[string]int, num)
+	for i := range num {
+		ids["j"+strconv.Itoa(i)] = 0
+	}
+	testStore(t, ids)
+	now := time.Now()
+	for i := range num {
+		dljob := g.store.dljobs["j"+strconv.Itoa(i)]
+		dljob.startedTime = now.Add(-time.Duration(num-i) * time.Minute) // j0 is the oldest
+		dljob.description = "even"
+		if i%2 == 1 {
+			dljob.description = "odd"
+		}
+	}
+	g.store.dljobs["j3"].startedTime = g.store.dljobs["j2"].startedTime // (same time: by ID)
+	g.store.dljobs["j5"].finishedTime.Store(now)
+
+	list := func(regex *regexp.Regexp, onlyActive bool, offset, limit int) (ids []string, total int) {
+		v, code, err := ListJobsPage(regex, onlyActive, offset, limit)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, code == http.StatusOK, "expecting %d, got %d", http.StatusOK, code)
+		page := v.(JobPage)
+		for _, job := range page.Jobs {
+			ids = append(ids, job.ID)
+		}
+		return ids, page.Total
+	}
+
+	// all pages, in order
+	var all []string
+	for offset := 0; ; offset += 3 {
+		ids, total := list(nil, false, offset, 3)
+		tassert.Fatalf(t, total == num, "expecting total %d, got %d", num, total)
+		if len(ids) == 0 {
+			break
+		}
+		tassert.Fatalf(t, len(ids) <= 3, "expecting at most 3, got %v", ids)
+		all = append(all, ids...)
+	}
+	tassert.Fatalf(t, len(all) == num, "expecting %d jobs, got %v", num, all)
+	for i, id := range all {
+		tassert.Errorf(t, id == "j"+strconv.Itoa(i), "expecting sorted by start time: %v", all)
+	}
+
+	// past the end
+	ids2, total := list(nil, false, num, 3)
+	tassert.Errorf(t, len(ids2) == 0 && total == num, "past the end: expecting none of %d, got %v (%d)", num, ids2, total)
+
+	// filters apply prior to paging; total counts all matching
+	ids2, total = list(regexp.MustCompile("^odd$"), false, 1, 2)
+	tassert.Errorf(t, total == 3 && len(ids2) == 2 && ids2[0] == "j3" && ids2[1] == "j5", "odd: unexpected %v (%d)", ids2, total)
+	ids2, total = list(regexp.MustCompile("^odd$"), true, 0, 10)
+	tassert.Errorf(t, total == 2 && len(ids2) == 2 && ids2[1] == "j3", "odd and active: unexpected %v (%d)", ids2, total)
+
+	// (sorted on the caller's side as well)
+	infos := JobInfos{{ID: "b", StartedTime: now}, {ID: "c", StartedTime: now.Add(-time.Hour)}, {ID: "a", StartedTime: now}}
+	infos.SortByStart()
+	tassert.Errorf(t, infos[0].ID == "c" && infos[1].ID == "a" && infos[2].ID == "b", "unexpected order %v", infos)
+}
+
+func TestListPageValidate(t *testing.T) {
+	tests := []struct {
+		body  AdminBody
+		valid bool
+	}{
+		{AdminBody{Limit: 10}, true},
+		{AdminBody{Offset: 20, Limit: 10}, true},
+		{AdminBody{Regex: "^x", Offset: 20, Limit: 10}, true},
+		{AdminBody{Offset: -1, Limit: 10}, false},
+		{AdminBody{Limit: -1}, false},
+		{AdminBody{Offset: 10}, false},
+		{AdminBody{Regex: "^x", Offset: 10}, false},
+		{AdminBody{Regex: "^x", Limit: -1}, false},
+	}
+	for _, test := range tests {
+		err := test.body.Validate(false)
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, got %v", test.body, test.valid, err)
+	}
+}
@@ -0,0 +1,156 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Merkle manifest (see Base.Manifest)
+// - leaves: (object name, checksum) of every downloaded or skipped-as-unchanged item,
+//   and the byte range when subsetting (see byterange.go)
+// - leaves are sorted by name, so that the root does not depend on the order of completion
+// - the root is computed upon job completion (one per target); job-level root is
+//   the XOR of per-target roots (see Job.Aggregate) - stable as long as the cluster
+//   membership (and therefore object distribution) does not change
+// - CksumType: destination bucket's checksum type at the time of the job - the type downloaded
+//   objects are stored with (computed inline, while writing); leaves of a different type
+//   (e.g., unchanged objects stored prior to bucket props update) are counted and logged
+
+const mftVer = 1
+
+type (
+	MfLeaf struct {
+		Name  string `json:"name"`
+		Cksum string `json:"cksum"`           // "type:value"
+		Range string `json:"range,omitempty"` // see SingleObj.Range
+		Size  int64  `json:"size,omitempty"`  // (not part of the root; see Base.VerifyJob)
+	}
+	Manifest struct {
+		JobID     string   `json:"job_id"`
+		Root      string   `json:"root"`
+		CksumType string   `json:"cksum_type,omitempty"`
+		Leaves    []MfLeaf `json:"leaves"`
+	}
+
+	manifest struct {
+		leaves    []MfLeaf
+		root      string
+		cksumType string // destination bucket's
+		otherType int    // number of leaves with a different checksum type
+		mu        sync.Mutex
+	}
+)
+
+func (m *manifest) add(name, rng string, size int64, cksum *cos.Cksum) {
+	leaf := MfLeaf{Name: name, Range: rng, Size: size}
+	if !cos.NoneC(cksum) {
+		leaf.Cksum = cksum.Ty() + ":" + cksum.Val()
+	}
+	m.mu.Lock()
+	m.leaves = append(m.leaves, leaf)
+	if cksum.Type() != m.cksumType {
+		m.otherType++
+	}
+	m.mu.Unlock()
+}
+
+func (m *manifest) getRoot() (root string) {
+	m.mu.Lock()
+	root = m.root
+	m.mu.Unlock()
+	return root
+}
+
+// compute the root and persist the manifest
+func (m *manifest) finalize(jobID string) {
+	m.mu.Lock()
+	sort.Slice(m.leaves, func(i, j int) bool { return m.leaves[i].Name < m.leaves[j].Name })
+	m.root = merkleRoot(m.leaves)
+	mft := &Manifest{JobID: jobID, Root: m.root, CksumType: m.cksumType, Leaves: m.leaves}
+	otherType := m.otherType
+	m.mu.Unlock()
+
+	if otherType > 0 {
+		nlog.Warningln("download job", jobID+":", otherType, "item(s) stored with checksum type other than", m.cksumType)
+	}
+
+	fpath := mftPath(jobID)
+	if err := jsp.Save(fpath, mft, jsp.CksumSign(mftVer), nil); err != nil {
+		nlog.Errorln("failed to save download manifest", fpath+":", err)
+	}
+}
+
+func mftPath(jobID string) string {
+	return filepath.Join(cmn.GCO.Get().ConfigDir, fname.DloadManifest+"."+jobID)
+}
+
+// (see verify.go)
+func loadManifest(jobID string) (*Manifest, error) {
+	mft := &Manifest{}
+	if _, err := jsp.Load(mftPath(jobID), mft, jsp.CksumSign(mftVer)); err != nil {
+		return nil, err
+	}
+	return mft, nil
+}
+
+func merkleRoot(leaves []MfLeaf) string {
+	if len(leaves) == 0 {
+		return ""
+	}
+	level := make([][sha256.Size]byte, len(leaves))
+	for i := range leaves {
+		s := leaves[i].Name + "\x00" + leaves[i].Cksum
+		if leaves[i].Range != "" {
+			s += "\x00" + leaves[i].Range // (same root as before when not subsetting)
+		}
+		level[i] = sha256.Sum256([]byte(s))
+	}
+	var buf [2 * sha256.Size]byte
+	for len(level) > 1 {
+		n := 0
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				level[n] = level[i] // odd one out: promote
+			} else {
+				copy(buf[:], level[i][:])
+				copy(buf[sha256.Size:], level[i+1][:])
+				level[n] = sha256.Sum256(buf[:])
+			}
+			n++
+		}
+		level = level[:n]
+	}
+	return hex.EncodeToString(level[0][:])
+}
+
+// job-level root: XOR of per-target roots (order-independent)
+func xorRoots(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	}
+	x, err1 := hex.DecodeString(a)
+	y, err2 := hex.DecodeString(b)
+	if err1 != nil || err2 != nil || len(x) != len(y) {
+		return a
+	}
+	for i := range x {
+		x[i] ^= y[i]
+	}
+	return hex.EncodeToString(x)
+}
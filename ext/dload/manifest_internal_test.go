@@ -0,0 +1,121 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// manifests are stored in the config dir (see mftPath)
+func testConfigDir(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	saved := config.ConfigDir
+	config.ConfigDir = t.TempDir()
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.ConfigDir = saved
+		cmn.GCO.CommitUpdate(config)
+	})
+}
+
+func testMft(names ...string) *manifest {
+	m := &manifest{cksumType: cos.ChecksumOneXxh}
+	for _, name := range names {
+		m.add(name, "", 10, cos.NewCksum(cos.ChecksumOneXxh, "c-"+name))
+	}
+	return m
+}
+
+func TestMerkleRoot(t *testing.T) {
+	testConfigDir(t)
+
+	tassert.Errorf(t, merkleRoot(nil) == "", "expecting empty root")
+
+	// single leaf: the leaf itself
+	leaf := MfLeaf{Name: "a", Cksum: cos.ChecksumOneXxh + ":c-a"}
+	h := sha256.Sum256([]byte("a\x00" + leaf.Cksum))
+	tassert.Errorf(t, merkleRoot([]MfLeaf{leaf}) == hex.EncodeToString(h[:]), "single leaf: unexpected root")
+
+	// order of completion does not matter
+	m1, m2 := testMft("a", "b", "c", "d", "e"), testMft("e", "c", "a", "d", "b")
+	m1.finalize("j1")
+	m2.finalize("j2")
+	tassert.Fatalf(t, m1.getRoot() != "" && m1.getRoot() == m2.getRoot(), "expecting the same root: %q vs %q",
+		m1.getRoot(), m2.getRoot())
+
+	// any change does
+	m3 := testMft("a", "b", "c", "d")
+	m3.add("e", "", 10, cos.NewCksum(cos.ChecksumOneXxh, "changed"))
+	m3.finalize("j3")
+	tassert.Errorf(t, m3.getRoot() != m1.getRoot(), "checksum change: expecting different root")
+
+	m4 := testMft("a", "b", "c", "d")
+	m4.add("e", "0-1", 10, cos.NewCksum(cos.ChecksumOneXxh, "c-e"))
+	m4.finalize("j4")
+	tassert.Errorf(t, m4.getRoot() != m1.getRoot(), "byte range: expecting different root")
+
+	m5 := testMft("a", "b", "c", "d")
+	m5.finalize("j5")
+	tassert.Errorf(t, m5.getRoot() != m1.getRoot(), "missing leaf: expecting different root")
+}
+
+func TestManifestPersist(t *testing.T) {
+	testConfigDir(t)
+
+	m := testMft("b", "a")
+	m.add("c", "", 10, cos.NewCksum(cos.ChecksumSHA256, "other"))
+	m.add("d", "", 10, nil)
+	tassert.Errorf(t, m.otherType == 2, "expecting 2 leaves of other checksum type, got %d", m.otherType)
+	m.finalize("job")
+
+	mft, err := loadManifest("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, mft.JobID == "job" && mft.Root == m.getRoot() && mft.CksumType == cos.ChecksumOneXxh,
+		"unexpected manifest %+v", mft)
+	tassert.Fatalf(t, len(mft.Leaves) == 4, "expecting 4 leaves, got %d", len(mft.Leaves))
+	tassert.Errorf(t, mft.Leaves[0].Name == "a" && mft.Leaves[3].Name == "d", "expecting sorted leaves: %+v", mft.Leaves)
+	tassert.Errorf(t, mft.Leaves[3].Cksum == "", "expecting no checksum: %+v", mft.Leaves[3])
+
+	_, err = loadManifest("no-such-job")
+	tassert.Errorf(t, cos.IsNotExist(err), "expecting not-exist, got %v", err)
+}
+
+func TestXorRoots(t *testing.T) {
+	var (
+		a = merkleRoot([]MfLeaf{{Name: "a"}})
+		b = merkleRoot([]MfLeaf{{Name: "b"}})
+		c = merkleRoot([]MfLeaf{{Name: "c"}})
+	)
+	tassert.Errorf(t, xorRoots(a, "") == a && xorRoots("", a) == a, "expecting empty root to be identity")
+	tassert.Errorf(t, xorRoots(a, b) == xorRoots(b, a), "expecting commutative")
+	tassert.Errorf(t, xorRoots(xorRoots(a, b), c) == xorRoots(a, xorRoots(b, c)), "expecting associative")
+	tassert.Errorf(t, xorRoots(a, a) == strings.Repeat("0", len(a)), "expecting zero root")
+	tassert.Errorf(t, xorRoots(a, "bad") == a, "invalid: expecting the first root")
+}
+
+func TestManifestFinish(t *testing.T) {
+	testConfigDir(t)
+	testStore(t, map[string]int{"fin": 0, "abrt": 0})
+	for _, dljob := range g.store.dljobs {
+		dljob.mft = testMft("a", "b")
+	}
+	g.store.dljobs["abrt"].aborted.Store(true)
+
+	for _, id := range []string{"fin", "abrt"} {
+		g.store.markFinished(id)
+	}
+	tassert.Errorf(t, g.store.dljobs["fin"].clone().MerkleRoot != "", "finished: expecting root")
+	tassert.Errorf(t, g.store.dljobs["abrt"].clone().MerkleRoot == "", "aborted: not expecting root")
+	_, err := loadManifest("abrt")
+	tassert.Errorf(t, cos.IsNotExist(err), "aborted: not expecting manifest, got %v", err)
+}
@@ -0,0 +1,93 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Destination naming (see Base.NameTemplate)
+//
+// Go text/template evaluated for each item; the result is the destination object name.
+// Data:
+// - .Name - default destination name (e.g. "subdir/file.tar" or the remote object name)
+// - .URL  - source link (empty for backend downloads)
+// - .Path - source URL path (empty for backend downloads)
+// Functions:
+// - basename STR            - last element of the path
+// - trimPrefix PREFIX STR   - strings.TrimPrefix
+// - replace REGEX REPL STR  - regexp.ReplaceAllString
+// - date LAYOUT             - current UTC time, Go layout (e.g. "2006/01/02")
+// Example:
+//   {{date "2006-01-02"}}/{{.Path | trimPrefix "/datasets/" | replace "\\.jpeg$" ".jpg"}}
+
+type (
+	nameTmpl struct {
+		t *template.Template
+	}
+	nameTmplData struct {
+		Name string
+		URL  string
+		Path string
+	}
+)
+
+var nameTmplFuncs = template.FuncMap{
+	"basename":   path.Base,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"replace": func(expr, repl, s string) (string, error) {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return "", err
+		}
+		return re.ReplaceAllString(s, repl), nil
+	},
+	"date": func(layout string) string { return time.Now().UTC().Format(layout) },
+}
+
+func newNameTmpl(s string) (*nameTmpl, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := template.New("name").Funcs(nameTmplFuncs).Option("missingkey=error").Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name template %q: %v", s, err)
+	}
+	// dry-run to catch runtime errors (e.g., bad regex, unknown field) upfront
+	nt := &nameTmpl{t: t}
+	if _, err := nt.apply("dir/name", "https://host/dir/name"); err != nil {
+		return nil, err
+	}
+	return nt, nil
+}
+
+// nil-safe: no template - default name
+func (nt *nameTmpl) apply(name, link string) (string, error) {
+	if nt == nil {
+		return name, nil
+	}
+	data := nameTmplData{Name: name, URL: link}
+	if link != "" {
+		if u, err := url.Parse(link); err == nil {
+			data.Path = u.Path
+		}
+	}
+	var sb strings.Builder
+	if err := nt.t.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("name template: %v", err)
+	}
+	out := strings.TrimSpace(sb.String())
+	if out == "" {
+		return "", errors.New("name template: empty object name (source: " + link + ")")
+	}
+	return out, nil
+}
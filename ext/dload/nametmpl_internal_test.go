@@ -0,0 +1,66 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNameTmpl(t *testing.T) {
+	const link = "https://host/datasets/imagenet/train/img-001.jpeg?sig=abc"
+	tests := []struct {
+		tmpl, name, link, expected string
+	}{
+		{"", "img-001.jpeg", link, "img-001.jpeg"},
+		{"{{.Name}}", "img-001.jpeg", link, "img-001.jpeg"},
+		{"{{.Path}}", "img-001.jpeg", link, "/datasets/imagenet/train/img-001.jpeg"},
+		{`{{.Path | trimPrefix "/datasets/"}}`, "img-001.jpeg", link, "imagenet/train/img-001.jpeg"},
+		{`{{.Path | basename | replace "\\.jpeg$" ".jpg"}}`, "img-001.jpeg", link, "img-001.jpg"},
+		{`prefix/{{.Name}}`, "dir/obj", "", "prefix/dir/obj"},
+		{` {{.Name}} `, "obj", "", "obj"},
+		{`{{date "2006"}}/{{.Name}}`, "obj", "", time.Now().UTC().Format("2006") + "/obj"},
+	}
+	for _, test := range tests {
+		nt, err := newNameTmpl(test.tmpl)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, (nt == nil) == (test.tmpl == ""), "%q: unexpected template %v", test.tmpl, nt)
+		name, err := nt.apply(test.name, test.link)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, name == test.expected, "%q: expecting %q, got %q", test.tmpl, test.expected, name)
+	}
+}
+
+func TestNameTmplInvalid(t *testing.T) {
+	for _, tmpl := range []string{
+		"{{.Name",                        // parse
+		"{{.NoSuchField}}",               // unknown field (dry-run)
+		`{{.Name | replace "(" "x"}}`,    // bad regex (dry-run)
+		`{{.Name | noSuchFunc}}`,         // unknown function
+		`{{if false}}{{.Name}}{{end}}  `, // empty name (dry-run)
+	} {
+		_, err := newNameTmpl(tmpl)
+		tassert.Errorf(t, err != nil, "%q: expecting error", tmpl)
+	}
+
+	// valid upfront, empty for a given item (backend downloads have no source path)
+	nt, err := newNameTmpl(`{{.Path}}`)
+	tassert.CheckFatal(t, err)
+	_, err = nt.apply("obj", "")
+	tassert.Errorf(t, err != nil, "expecting empty name error")
+}
+
+func TestNameTmplDlObj(t *testing.T) {
+	bck := testTarget(t)
+	nt, err := newNameTmpl(`renamed/{{.Name}}`)
+	tassert.CheckFatal(t, err)
+	obj, err := makeDlObj(core.T.Sowner().Get(), core.T.SID(), bck, nt, "obj?query=1", "host/obj")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, obj.objName == "renamed/obj", "expecting template applied and normalized, got %q", obj.objName)
+	tassert.Errorf(t, obj.link == "http://host/obj", "expecting protocol prepended, got %q", obj.link)
+}
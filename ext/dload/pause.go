@@ -0,0 +1,77 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Global pause ("big red button")
+//
+// PauseAll freezes all downloads without aborting any jobs: dispatching stops
+// before the next task gets queued, and joggers stop before starting the next
+// queued task (tasks in progress are allowed to finish). ResumeAll continues
+// from where it left off.
+// The switch is global, independent of job priorities and per-job controls,
+// and survives xaction renewal.
+
+type pauseGate struct {
+	ch     chan struct{} // closed when not paused
+	mu     sync.Mutex
+	paused bool
+}
+
+var gpause = newPauseGate()
+
+func newPauseGate() *pauseGate {
+	pg := &pauseGate{ch: make(chan struct{})}
+	close(pg.ch)
+	return pg
+}
+
+func PauseAll() {
+	gpause.mu.Lock()
+	if !gpause.paused {
+		gpause.paused = true
+		gpause.ch = make(chan struct{})
+		nlog.Warningln("downloader: paused")
+	}
+	gpause.mu.Unlock()
+}
+
+func ResumeAll() {
+	gpause.mu.Lock()
+	if gpause.paused {
+		gpause.paused = false
+		close(gpause.ch)
+		nlog.Infoln("downloader: resumed")
+	}
+	gpause.mu.Unlock()
+}
+
+func IsPaused() bool {
+	gpause.mu.Lock()
+	paused := gpause.paused
+	gpause.mu.Unlock()
+	return paused
+}
+
+// returns false iff the job was aborted or the dispatcher stopped (or started draining) while waiting
+func (pg *pauseGate) wait(abortCh, stopCh, drainCh *cos.StopCh) bool {
+	pg.mu.Lock()
+	ch := pg.ch
+	pg.mu.Unlock()
+	select {
+	case <-ch:
+		return true
+	case <-abortCh.Listen():
+	case <-stopCh.Listen():
+	case <-drainCh.Listen():
+	}
+	return false
+}
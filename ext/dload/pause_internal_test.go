@@ -0,0 +1,64 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPauseAll(t *testing.T) {
+	const (
+		jobID = "job"
+		num   = 5
+	)
+	var (
+		d = &dispatcher{
+			stopCh:   cos.NewStopCh(),
+			drainCh:  cos.NewStopCh(),
+			abortJob: map[string]*cos.StopCh{jobID: cos.NewStopCh()},
+		}
+		j   = newJogger(d, "/tmp/mpath")
+		job = &sliceDlJob{baseDlJob: baseDlJob{id: jobID}}
+	)
+	PauseAll()
+	defer ResumeAll()
+	tassert.Fatalf(t, IsPaused(), "expecting paused")
+
+	// queued tasks that are no longer pending (not in the queue's set) get skipped
+	// right past the gate, without downloading anything
+	j.run()
+	for i := range num {
+		j.q.ch <- &singleTask{job: job, obj: dlObj{objName: "o" + strconv.Itoa(i)}}
+	}
+
+	// the jogger takes one task and stops at the gate
+	time.Sleep(100 * time.Millisecond)
+	tassert.Errorf(t, len(j.q.ch) == num-1, "paused: expecting %d queued, got %d", num-1, len(j.q.ch))
+
+	ResumeAll()
+	tassert.Fatalf(t, !IsPaused(), "expecting resumed")
+	deadline := time.Now().Add(5 * time.Second)
+	for len(j.q.ch) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	tassert.Errorf(t, len(j.q.ch) == 0, "resumed: expecting all dispatched, %d remain queued", len(j.q.ch))
+
+	// pausing again blocks the next one
+	PauseAll()
+	PauseAll() // (idempotent)
+	j.q.ch <- &singleTask{job: job, obj: dlObj{objName: "last"}}
+	j.q.ch <- &singleTask{job: job, obj: dlObj{objName: "next"}}
+	time.Sleep(100 * time.Millisecond)
+	tassert.Errorf(t, len(j.q.ch) == 1, "paused again: expecting 1 queued, got %d", len(j.q.ch))
+
+	// stopping the dispatcher releases the gate
+	d.stopCh.Close()
+	j.stop()
+}
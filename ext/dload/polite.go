@@ -0,0 +1,202 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Per-host politeness (see Limits.PerHost and Limits.HostDelay), to keep large crawls
+// from getting rate-limited or banned by the origin:
+// - at most PerHost in-flight requests to any given source host (all retries included)
+// - consecutive requests to the same host are spaced out by (at least) HostDelay
+// - 429 and 503 responses double the host's delay (up to hostDelayMax), and Retry-After,
+//   if present, is respected; successful responses halve it back toward HostDelay
+// - HTTP(S) sources only; remote-backend and ETL downloads are not affected
+// - per target: with N targets, a host may see up to N*PerHost concurrent requests
+// - reported via Job.Hosts (the busiest hostStatsMax hosts)
+
+const (
+	hostDelayMin = 100 * time.Millisecond // first backoff when HostDelay is zero
+	hostDelayMax = time.Minute
+
+	hostStatsMax = 16
+)
+
+type (
+	politeness struct {
+		hosts   map[string]*polHost
+		perHost int
+		delay   time.Duration // base
+		mu      sync.Mutex
+	}
+	polHost struct {
+		sema  chan struct{} // nil: no in-flight limit
+		next  time.Time     // earliest next request
+		delay time.Duration // current: base or backed off
+		stats HostStats
+	}
+)
+
+// nil when neither is configured
+func newPoliteness(limits *Limits) *politeness {
+	if limits.PerHost == 0 && limits.HostDelay == "" {
+		return nil
+	}
+	delay, _ := time.ParseDuration(limits.HostDelay) // validated
+	return &politeness{
+		hosts:   make(map[string]*polHost, 16),
+		perHost: limits.PerHost,
+		delay:   delay,
+	}
+}
+
+func linkHost(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// wait for an in-flight slot, and then for the host's turn
+// (nil-safe: returns nil when politeness is not configured)
+func (p *politeness) acquire(ctx context.Context, host string) (*polHost, error) {
+	if p == nil || host == "" {
+		return nil, nil
+	}
+	p.mu.Lock()
+	h := p.hosts[host]
+	if h == nil {
+		h = &polHost{delay: p.delay, stats: HostStats{Host: host}}
+		if p.perHost > 0 {
+			h.sema = make(chan struct{}, p.perHost)
+		}
+		p.hosts[host] = h
+	}
+	p.mu.Unlock()
+
+	if h.sema != nil {
+		select {
+		case h.sema <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p.mu.Lock()
+	now := time.Now()
+	wait := h.next.Sub(now)
+	if wait > 0 {
+		h.next = h.next.Add(h.delay)
+		h.stats.Waited += wait
+	} else {
+		h.next = now.Add(h.delay)
+	}
+	h.stats.Requests++
+	p.mu.Unlock()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			p.release(h, nil)
+			return nil, ctx.Err()
+		}
+	}
+	return h, nil
+}
+
+// adjust the host's delay based on the response (nil: no response), and release the slot
+func (p *politeness) release(h *polHost, resp *http.Response) {
+	if h == nil {
+		return
+	}
+	p.mu.Lock()
+	switch {
+	case resp == nil:
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		h.delay = min(max(2*h.delay, hostDelayMin), hostDelayMax)
+		h.stats.Throttled++
+		next := time.Now().Add(h.delay)
+		if secs, err := strconv.Atoi(resp.Header.Get(cos.HdrRetryAfter)); err == nil && secs > 0 {
+			next = time.Now().Add(min(time.Duration(secs)*time.Second, hostDelayMax))
+		}
+		if next.After(h.next) {
+			h.next = next
+		}
+	case resp.StatusCode < http.StatusBadRequest && h.delay > p.delay:
+		h.delay = max(h.delay/2, p.delay)
+	}
+	p.mu.Unlock()
+
+	if h.sema != nil {
+		<-h.sema
+	}
+}
+
+// the busiest hosts, in descending order
+func (p *politeness) stats() []HostStats {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	out := make([]HostStats, 0, len(p.hosts))
+	for _, h := range p.hosts {
+		st := h.stats
+		st.Delay = h.delay
+		out = append(out, st)
+	}
+	p.mu.Unlock()
+	sortHostStats(out)
+	if len(out) > hostStatsMax {
+		out = out[:hostStatsMax]
+	}
+	return out
+}
+
+func sortHostStats(hs []HostStats) {
+	sort.Slice(hs, func(i, j int) bool {
+		if hs[i].Requests != hs[j].Requests {
+			return hs[i].Requests > hs[j].Requests
+		}
+		return hs[i].Host < hs[j].Host
+	})
+}
+
+// (see Job.Aggregate)
+func mergeHostStats(a, b []HostStats) []HostStats {
+	if len(b) == 0 {
+		return a
+	}
+outer:
+	for _, st := range b {
+		for i := range a {
+			if a[i].Host == st.Host {
+				a[i].Requests += st.Requests
+				a[i].Throttled += st.Throttled
+				a[i].Waited += st.Waited
+				a[i].Delay = max(a[i].Delay, st.Delay)
+				continue outer
+			}
+		}
+		a = append(a, st)
+	}
+	sortHostStats(a)
+	if len(a) > hostStatsMax {
+		a = a[:hostStatsMax]
+	}
+	return a
+}
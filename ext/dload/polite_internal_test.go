@@ -0,0 +1,143 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPolitenessNil(t *testing.T) {
+	p := newPoliteness(&Limits{})
+	tassert.Fatalf(t, p == nil, "expecting nil when not configured")
+	h, err := p.acquire(context.Background(), "host")
+	tassert.Errorf(t, h == nil && err == nil, "nil-safe acquire")
+	p.release(h, nil)
+	tassert.Errorf(t, p.stats() == nil, "nil-safe stats")
+
+	p = newPoliteness(&Limits{PerHost: 1})
+	h, err = p.acquire(context.Background(), "")
+	tassert.Errorf(t, h == nil && err == nil, "expecting no-op for non-HTTP sources")
+	tassert.Errorf(t, linkHost("https://host:8080/a/b") == "host:8080", "unexpected host")
+}
+
+func TestPolitenessPerHost(t *testing.T) {
+	p := newPoliteness(&Limits{PerHost: 2})
+	ctx := context.Background()
+	h1, err := p.acquire(ctx, "a")
+	tassert.CheckFatal(t, err)
+	_, err = p.acquire(ctx, "a")
+	tassert.CheckFatal(t, err)
+	_, err = p.acquire(ctx, "b") // (other hosts are not affected)
+	tassert.CheckFatal(t, err)
+
+	// third in-flight request to the same host waits for a slot
+	ch := make(chan error, 1)
+	go func() {
+		_, err := p.acquire(ctx, "a")
+		ch <- err
+	}()
+	select {
+	case err := <-ch:
+		t.Fatalf("not expecting to acquire (err: %v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	p.release(h1, nil)
+	select {
+	case err := <-ch:
+		tassert.CheckFatal(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expecting to acquire upon release")
+	}
+
+	// canceled while waiting
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = p.acquire(cctx, "a")
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expecting deadline exceeded, got %v", err)
+}
+
+func TestPolitenessDelay(t *testing.T) {
+	const (
+		delay = 50 * time.Millisecond
+		num   = 4
+	)
+	p := newPoliteness(&Limits{HostDelay: delay.String()})
+	started := time.Now()
+	for range num {
+		h, err := p.acquire(context.Background(), "a")
+		tassert.CheckFatal(t, err)
+		p.release(h, &http.Response{StatusCode: http.StatusOK})
+	}
+	elapsed := time.Since(started)
+	tassert.Errorf(t, elapsed >= (num-1)*delay, "expecting requests spaced by %v, took %v", delay, elapsed)
+
+	st := p.stats()
+	tassert.Fatalf(t, len(st) == 1, "expecting 1 host, got %d", len(st))
+	tassert.Errorf(t, st[0].Host == "a" && st[0].Requests == num && st[0].Waited > 0 && st[0].Delay == delay,
+		"unexpected stats %+v", st[0])
+}
+
+func TestPolitenessBackoff(t *testing.T) {
+	p := newPoliteness(&Limits{PerHost: 1})
+	ctx := context.Background()
+	throttled := func(retryAfter int) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		if retryAfter > 0 {
+			resp.Header.Set(cos.HdrRetryAfter, strconv.Itoa(retryAfter))
+		}
+		return resp
+	}
+
+	h, err := p.acquire(ctx, "a")
+	tassert.CheckFatal(t, err)
+	p.release(h, throttled(0))
+	tassert.Errorf(t, h.delay == hostDelayMin, "expecting first backoff %v, got %v", hostDelayMin, h.delay)
+
+	h, _ = p.acquire(ctx, "a")
+	p.release(h, &http.Response{StatusCode: http.StatusServiceUnavailable})
+	tassert.Errorf(t, h.delay == 2*hostDelayMin, "expecting doubled %v, got %v", 2*hostDelayMin, h.delay)
+
+	// Retry-After
+	h, _ = p.acquire(ctx, "a")
+	p.release(h, throttled(30))
+	tassert.Errorf(t, time.Until(h.next) > 25*time.Second, "expecting Retry-After respected, next in %v", time.Until(h.next))
+
+	// capped
+	for range 20 {
+		h.sema <- struct{}{} // (take the slot without waiting for the host's turn)
+		p.release(h, throttled(0))
+	}
+	tassert.Errorf(t, h.delay == hostDelayMax, "expecting capped at %v, got %v", hostDelayMax, h.delay)
+
+	// success halves back toward the base
+	h.sema <- struct{}{}
+	p.release(h, &http.Response{StatusCode: http.StatusOK})
+	tassert.Errorf(t, h.delay == hostDelayMax/2, "expecting halved, got %v", h.delay)
+	tassert.Errorf(t, p.stats()[0].Throttled == 23, "expecting 23 throttled, got %d", p.stats()[0].Throttled)
+}
+
+func TestHostStats(t *testing.T) {
+	var a, b []HostStats
+	for i := range hostStatsMax {
+		a = append(a, HostStats{Host: "h" + strconv.Itoa(i), Requests: int64(i)})
+	}
+	b = []HostStats{{Host: "h0", Requests: 100, Throttled: 1, Delay: time.Second}, {Host: "new", Requests: 50}}
+	out := mergeHostStats(a, b)
+	tassert.Fatalf(t, len(out) == hostStatsMax, "expecting %d, got %d", hostStatsMax, len(out))
+	tassert.Errorf(t, out[0].Host == "h0" && out[0].Requests == 100 && out[0].Throttled == 1 && out[0].Delay == time.Second,
+		"expecting merged busiest first, got %+v", out[0])
+	tassert.Errorf(t, out[1].Host == "new", "expecting new host second, got %+v", out[1])
+	for i := 1; i < len(out); i++ {
+		tassert.Errorf(t, out[i-1].Requests >= out[i].Requests, "expecting descending order: %+v", out)
+	}
+}
@@ -0,0 +1,120 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Preflight (dry-run)
+//
+// Estimates this target's share of a would-be download job: number of objects
+// and total size. Does not create the job and does not download anything.
+// - backend: sizes from the remote listing
+// - links: HEAD requests, up to `preflightMaxHead` per target; the rest
+//   gets counted but not sized (see Estimate.Partial)
+
+const preflightMaxHead = 1000
+
+type Estimate struct {
+	Objects int   `json:"objects"`
+	Bytes   int64 `json:"bytes,string"`
+	Unsized int   `json:"unsized,omitempty"` // number of objects of unknown size (not included in Bytes)
+	Partial bool  `json:"partial,omitempty"` // when true, Bytes is a lower bound
+}
+
+func (e *Estimate) Merge(rhs *Estimate) {
+	e.Objects += rhs.Objects
+	e.Bytes += rhs.Bytes
+	e.Unsized += rhs.Unsized
+	e.Partial = e.Partial || rhs.Partial
+}
+
+func Preflight(bck *meta.Bck, dlb Body) (*Estimate, error) {
+	job, err := ParseStartRequest(bck, "preflight", dlb, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer job.throttler().stop()
+
+	if bj, ok := job.(*backendDlJob); ok {
+		return bj.preflight()
+	}
+	var (
+		est   = &Estimate{}
+		nhead int
+	)
+	for {
+		objs, ok, err := job.genNext()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		for _, obj := range objs {
+			est.Objects++
+			if obj.link == "" || nhead >= preflightMaxHead {
+				est.Unsized++
+				continue
+			}
+			nhead++
+			resp, err := headLink(obj.link)
+			if err != nil {
+				est.Unsized++
+				continue
+			}
+			cos.Close(resp.Body)
+			if resp.StatusCode >= 400 || resp.ContentLength < 0 {
+				est.Unsized++
+				continue
+			}
+			est.Bytes += resp.ContentLength
+		}
+	}
+	est.Partial = est.Unsized > 0
+	return est, nil
+}
+
+// same listing as getNextObjs, minus dlObj-s and plus sizes
+func (j *backendDlJob) preflight() (*Estimate, error) {
+	var (
+		est     = &Estimate{}
+		sid     = core.T.SID()
+		smap    = core.T.Sowner().Get()
+		backend = core.T.Backend(j.bck)
+		token   string
+	)
+	for {
+		var (
+			lst = &cmn.LsoRes{}
+			msg = &apc.LsoMsg{Prefix: j.prefix, ContinuationToken: token, PageSize: j.bck.MaxPageSize()}
+		)
+		if _, err := backend.ListObjects(j.bck, msg, lst); err != nil {
+			return nil, err
+		}
+		for _, en := range lst.Entries {
+			if !j.checkObj(en.Name) {
+				continue
+			}
+			if _, err := makeDlObj(smap, sid, j.bck, j.nt, en.Name, ""); err != nil {
+				if err == errInvalidTarget {
+					continue // not ours
+				}
+				return nil, err
+			}
+			est.Objects++
+			est.Bytes += en.Size
+		}
+		if token = lst.ContinuationToken; token == "" {
+			break
+		}
+	}
+	return est, nil
+}
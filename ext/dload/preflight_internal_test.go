@@ -0,0 +1,90 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	testSowner     struct{ smap meta.Smap }
+	testSlisteners struct{}
+)
+
+func (so *testSowner) Get() *meta.Smap            { return &so.smap }
+func (*testSowner) Listeners() meta.SmapListeners { return &testSlisteners{} }
+func (*testSlisteners) Reg(meta.Slistener)        {}
+func (*testSlisteners) Unreg(meta.Slistener)      {}
+
+// single-target cluster (all objects are local) and the download clients
+func testTarget(t *testing.T) *meta.Bck {
+	savedT := core.T
+	tmock := mock.NewTarget(nil)
+	tmock.SO = &testSowner{smap: meta.Smap{Tmap: meta.NodeMap{tmock.SID(): tmock.Snode()}}}
+	core.T = tmock
+
+	savedH, savedTLS := g.clientH, g.clientTLS
+	g.clientH, g.clientTLS = http.DefaultClient, http.DefaultClient
+	t.Cleanup(func() {
+		core.T = savedT
+		g.clientH, g.clientTLS = savedH, savedTLS
+	})
+
+	return meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+}
+
+func TestPreflight(t *testing.T) {
+	bck := testTarget(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tassert.Errorf(t, r.Method == http.MethodHead, "expecting HEAD, got %s", r.Method)
+		switch r.URL.Path {
+		case "/a":
+			w.Header().Set(cos.HdrContentLength, "100")
+		case "/b":
+			w.Header().Set(cos.HdrContentLength, "200")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	body := Body{
+		Type: TypeMulti,
+		RawMessage: []byte(`{"bucket": {"name": "bck"}, "objects": ["` + srv.URL + `/a", "` + srv.URL + `/b", "` +
+			srv.URL + `/missing"]}`),
+	}
+	est, err := Preflight(bck, body)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, est.Objects == 3 && est.Bytes == 300 && est.Unsized == 1 && est.Partial,
+		"expecting 3 objects, 300 bytes, 1 unsized, got %+v", est)
+
+	// all sized
+	body.RawMessage = []byte(`{"bucket": {"name": "bck"}, "objects": ["` + srv.URL + `/a", "` + srv.URL + `/b"]}`)
+	est, err = Preflight(bck, body)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, est.Objects == 2 && est.Bytes == 300 && !est.Partial, "expecting 2 objects, 300 bytes, got %+v", est)
+
+	// invalid request
+	_, err = Preflight(bck, Body{Type: TypeMulti, RawMessage: []byte(`{"objects": []}`)})
+	tassert.Errorf(t, err != nil, "expecting error (missing bucket)")
+}
+
+func TestEstimateMerge(t *testing.T) {
+	est := &Estimate{Objects: 2, Bytes: 300}
+	est.Merge(&Estimate{Objects: 3, Bytes: 100, Unsized: 1, Partial: true})
+	est.Merge(&Estimate{Objects: 1, Bytes: 50})
+	tassert.Errorf(t, est.Objects == 6 && est.Bytes == 450 && est.Unsized == 1 && est.Partial,
+		"unexpected merged estimate %+v", est)
+}
@@ -0,0 +1,163 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Job priorities
+//
+// Each job is registered with the priority gate for as long as it is dispatching
+// (see dispatcher.dispatchDownload) - not only while putting a given task into a
+// jogger's queue but also in-between tasks. Before putting its next task, a job
+// must pass through the gate, which admits it iff there's no other registered job
+// with a strictly higher _effective_ priority. Effective priority is the job's
+// (runtime-adjustable) priority plus a starvation-guard boost of +1 for every
+// `prioAging` interval spent waiting at the gate.
+//
+// Jobs of equal priority never block each other - with default (zero) priorities
+// the gate is effectively a no-op.
+//
+// Priority of a running job can be changed at runtime (see Xact.SetPriority);
+// waiting jobs re-evaluate immediately.
+
+const prioAging = time.Minute
+
+type (
+	prioWaiter struct {
+		dljob *dljob
+		since int64 // mono time when started waiting at the gate (zero when not waiting)
+		cnt   int   // num dispatching goroutines of the same job
+	}
+	prioGate struct {
+		waiters map[string]*prioWaiter // jobID -> waiter
+		ch      chan struct{}          // closed (and replaced) upon any change
+		mu      sync.Mutex
+	}
+)
+
+func (pg *prioGate) init() {
+	pg.waiters = make(map[string]*prioWaiter, 4)
+	pg.ch = make(chan struct{})
+}
+
+// register dispatching job; must be followed by `remove` when done dispatching
+func (pg *prioGate) add(jobID string) {
+	dljob, err := g.store.getJob(jobID)
+	if err != nil {
+		return // (unlikely)
+	}
+	pg.mu.Lock()
+	w, ok := pg.waiters[jobID]
+	if !ok {
+		w = &prioWaiter{dljob: dljob}
+		pg.waiters[jobID] = w
+	}
+	w.cnt++
+	pg.mu.Unlock()
+}
+
+func (pg *prioGate) remove(jobID string) {
+	pg.mu.Lock()
+	if w, ok := pg.waiters[jobID]; ok {
+		w.cnt--
+		if w.cnt <= 0 {
+			delete(pg.waiters, jobID)
+			pg._wake()
+		}
+	}
+	pg.mu.Unlock()
+}
+
+// returns false iff the job was aborted or the dispatcher stopped (or started draining)
+// while waiting; a job that is not registered (see `add`) is never held back
+func (pg *prioGate) enter(jobID string, abortCh, stopCh, drainCh *cos.StopCh) (ok bool) {
+	pg.mu.Lock()
+	w, registered := pg.waiters[jobID]
+	if !registered {
+		pg.mu.Unlock()
+		return true
+	}
+	w.since = mono.NanoTime()
+	for {
+		if !pg.outranked(w, mono.NanoTime()) {
+			ok = true
+			break
+		}
+		ch := pg.ch
+		pg.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-time.After(prioAging):
+		case <-abortCh.Listen():
+		case <-stopCh.Listen():
+		case <-drainCh.Listen():
+		}
+		pg.mu.Lock()
+		if abortCh.Stopped() || stopCh.Stopped() || drainCh.Stopped() {
+			break
+		}
+	}
+	w.since = 0
+	pg.mu.Unlock()
+	return ok
+}
+
+func (pg *prioGate) wake() {
+	pg.mu.Lock()
+	pg._wake()
+	pg.mu.Unlock()
+}
+
+// PRECONDITION: pg.mu must be locked
+func (pg *prioGate) _wake() {
+	close(pg.ch)
+	pg.ch = make(chan struct{})
+}
+
+// PRECONDITION: pg.mu must be locked
+func (pg *prioGate) outranked(w *prioWaiter, now int64) bool {
+	p := w.eff(now)
+	for _, other := range pg.waiters {
+		if other != w && other.eff(now) > p {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *prioWaiter) eff(now int64) int64 {
+	p := int64(w.dljob.priority.Load())
+	if w.since != 0 {
+		p += (now - w.since) / int64(prioAging)
+	}
+	return p
+}
+
+func (d *dispatcher) handlePriority(req *request) {
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		return
+	}
+	if job := dljob.clone(); !job.JobRunning() {
+		req.errRsp(fmt.Errorf("job %q is not running", dljob.id), http.StatusBadRequest)
+		return
+	}
+	prev := dljob.priority.Swap(int32(req.priority))
+	if prev != int32(req.priority) {
+		nlog.Infof("download job %q: priority %d => %d", dljob.id, prev, req.priority)
+		d.prio.wake()
+	}
+	req.okRsp(nil)
+}
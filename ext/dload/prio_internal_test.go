@@ -0,0 +1,250 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// store (backed by a temp kvdb) with the given (running) jobs and priorities; restored upon cleanup
+func testStore(t *testing.T, prios map[string]int) {
+	driver, err := kvdb.NewBuntDB(filepath.Join(t.TempDir(), "dload.db"))
+	tassert.CheckFatal(t, err)
+	saved := g.store
+	g.store = &infoStore{downloaderDB: newDownloadDB(driver, ""), dljobs: make(map[string]*dljob, len(prios))}
+	for id, p := range prios {
+		dljob := &dljob{id: id, startedTime: time.Now()}
+		dljob.priority.Store(int32(p))
+		g.store.dljobs[id] = dljob
+	}
+	t.Cleanup(func() {
+		g.store = saved
+		driver.Close()
+	})
+}
+
+// enter the gate in the background; the returned channel gets the result
+func testEnter(pg *prioGate, jobID string, stopCh *cos.StopCh) <-chan bool {
+	ch := make(chan bool, 1)
+	go func() { ch <- pg.enter(jobID, cos.NewStopCh(), stopCh, cos.NewStopCh()) }()
+	return ch
+}
+
+func testAdmitted(t *testing.T, ch <-chan bool, expected bool, what string) {
+	t.Helper()
+	select {
+	case ok := <-ch:
+		tassert.Fatalf(t, expected && ok, "%s: not expecting to pass the gate (ok=%t)", what, ok)
+	case <-time.After(100 * time.Millisecond):
+		tassert.Fatalf(t, !expected, "%s: expecting to pass the gate", what)
+	}
+}
+
+func TestPrioGateOrder(t *testing.T) {
+	testStore(t, map[string]int{"hi": 5, "lo": 0})
+	var (
+		pg     prioGate
+		stopCh = cos.NewStopCh()
+	)
+	pg.init()
+	pg.add("hi")
+	pg.add("lo")
+
+	testAdmitted(t, testEnter(&pg, "hi", stopCh), true, "hi")
+	lo := testEnter(&pg, "lo", stopCh)
+	testAdmitted(t, lo, false, "lo while hi is dispatching")
+
+	// in-between tasks, hi still outranks
+	testAdmitted(t, testEnter(&pg, "hi", stopCh), true, "hi (next task)")
+	testAdmitted(t, lo, false, "lo while hi is still dispatching")
+
+	pg.remove("hi")
+	testAdmitted(t, lo, true, "lo after hi is done")
+	pg.remove("lo")
+	tassert.Errorf(t, len(pg.waiters) == 0, "expecting no waiters, got %d", len(pg.waiters))
+
+	// (not registered: never held back)
+	pg.add("hi")
+	testAdmitted(t, testEnter(&pg, "lo", stopCh), true, "lo (unregistered)")
+
+	// (stopped while waiting)
+	pg.add("lo")
+	lo = testEnter(&pg, "lo", stopCh)
+	testAdmitted(t, lo, false, "lo")
+	stopCh.Close()
+	select {
+	case ok := <-lo:
+		tassert.Errorf(t, !ok, "expecting false upon stop")
+	case <-time.After(time.Second):
+		t.Fatal("lo: stuck upon stop")
+	}
+	tassert.Errorf(t, pg.waiters["lo"].since == 0, "expecting lo no longer waiting")
+}
+
+func TestPrioGateEqual(t *testing.T) {
+	testStore(t, map[string]int{"a": 0, "b": 0, "c": 3, "d": 3})
+	var (
+		pg     prioGate
+		stopCh = cos.NewStopCh()
+	)
+	pg.init()
+	for _, id := range []string{"a", "b", "a"} {
+		pg.add(id)
+	}
+
+	// equal priorities never block each other
+	testAdmitted(t, testEnter(&pg, "a", stopCh), true, "a")
+	testAdmitted(t, testEnter(&pg, "b", stopCh), true, "b")
+	testAdmitted(t, testEnter(&pg, "a", stopCh), true, "a (again)")
+
+	pg.add("c")
+	pg.add("d")
+	testAdmitted(t, testEnter(&pg, "c", stopCh), true, "c")
+	testAdmitted(t, testEnter(&pg, "d", stopCh), true, "d")
+	a := testEnter(&pg, "a", stopCh)
+	testAdmitted(t, a, false, "a vs c and d")
+
+	// (registered twice - by two dispatching goroutines)
+	pg.remove("c")
+	pg.remove("d")
+	testAdmitted(t, a, true, "a after c and d")
+	pg.remove("a")
+	tassert.Fatalf(t, pg.waiters["a"] != nil, "expecting a still registered")
+	pg.remove("a")
+	pg.remove("b")
+	tassert.Errorf(t, len(pg.waiters) == 0, "expecting no waiters, got %d", len(pg.waiters))
+	stopCh.Close()
+}
+
+func TestPrioAging(t *testing.T) {
+	testStore(t, map[string]int{"hi": 2, "lo": 0})
+	var (
+		pg  prioGate
+		now = mono.NanoTime()
+	)
+	pg.init()
+	hi := &prioWaiter{dljob: g.store.dljobs["hi"], cnt: 1}
+	lo := &prioWaiter{dljob: g.store.dljobs["lo"], since: now, cnt: 1}
+	pg.waiters["hi"], pg.waiters["lo"] = hi, lo
+
+	tassert.Errorf(t, pg.outranked(lo, now) && !pg.outranked(hi, now), "fresh: expecting hi to win")
+
+	// +1 per prioAging spent waiting
+	lo.since = now - 2*int64(prioAging)
+	tassert.Errorf(t, lo.eff(now) == 2, "expecting effective priority 2, got %d", lo.eff(now))
+	tassert.Errorf(t, !pg.outranked(lo, now) && !pg.outranked(hi, now), "2 vs 2: expecting no one outranked")
+
+	lo.since = now - 3*int64(prioAging) - 1
+	tassert.Errorf(t, !pg.outranked(lo, now) && pg.outranked(hi, now), "3 vs 2: expecting starving lo to win")
+
+	// not waiting at the gate: no boost
+	hi.since = 0
+	lo.since = 0
+	tassert.Errorf(t, lo.eff(now+10*int64(prioAging)) == 0, "expecting no boost when not waiting")
+}
+
+func TestPrioRuntimeChange(t *testing.T) {
+	testStore(t, map[string]int{"hi": 5, "lo": 0})
+	var (
+		d      = &dispatcher{}
+		stopCh = cos.NewStopCh()
+	)
+	d.prio.init()
+	d.prio.add("hi")
+	d.prio.add("lo")
+	defer stopCh.Close()
+
+	testAdmitted(t, testEnter(&d.prio, "hi", stopCh), true, "hi")
+	lo := testEnter(&d.prio, "lo", stopCh)
+	testAdmitted(t, lo, false, "lo")
+
+	req := &request{action: actPrio, id: "lo", priority: 7}
+	d.handlePriority(req)
+	tassert.Fatalf(t, req.response.err == nil, "unexpected error: %v", req.response.err)
+	tassert.Errorf(t, g.store.dljobs["lo"].priority.Load() == 7, "expecting priority 7")
+	testAdmitted(t, lo, true, "lo upon raised priority")
+	testAdmitted(t, testEnter(&d.prio, "hi", stopCh), false, "hi upon lo's raised priority")
+
+	// finished jobs: not allowed
+	g.store.dljobs["hi"].finishedTime.Store(time.Now())
+	g.store.dljobs["hi"].aborted.Store(true)
+	req = &request{action: actPrio, id: "hi", priority: 1}
+	d.handlePriority(req)
+	tassert.Errorf(t, req.response.statusCode == http.StatusBadRequest, "expecting %d, got %d",
+		http.StatusBadRequest, req.response.statusCode)
+}
+
+// two jobs of different priority contend for a (full) jogger queue:
+// all high-priority tasks get queued first
+func TestPrioDispatch(t *testing.T) {
+	const num = 4
+	bck := testMpath(t)
+	testStore(t, map[string]int{"hi": 5, "lo": 0})
+	xdl := &Xact{}
+	xdl.InitBase(cos.GenUUID(), apc.ActDownload, nil)
+	d := &dispatcher{
+		xdl:      xdl,
+		joggers:  make(map[string]*jogger, 1),
+		stopCh:   cos.NewStopCh(),
+		drainCh:  cos.NewStopCh(),
+		abortJob: map[string]*cos.StopCh{"hi": cos.NewStopCh(), "lo": cos.NewStopCh()},
+	}
+	d.prio.init()
+	defer d.stopCh.Close()
+	var j *jogger
+	for mpath := range fs.GetAvail() {
+		j = newJogger(d, mpath)
+		j.q.ch = make(chan *singleTask, 1)
+		j.q.ch <- &singleTask{} // (full)
+		d.joggers[mpath] = j
+	}
+	tassert.Fatalf(t, len(d.joggers) == 1, "expecting a single mountpath, got %d", len(d.joggers))
+
+	// (registered as dispatchDownload does, and done dispatching upon return)
+	dispatch := func(id string) {
+		job := &sliceDlJob{baseDlJob: baseDlJob{id: id, bck: bck}}
+		job.throttler().init(Limits{})
+		defer d.prio.remove(id)
+		for i := range num {
+			ok, err := d.doSingle(&singleTask{job: job, obj: dlObj{objName: id + strconv.Itoa(i), link: "http://host/" + id}})
+			tassert.Errorf(t, ok && err == nil, "%s: failed to dispatch (%t, %v)", id, ok, err)
+		}
+	}
+	d.prio.add("lo")
+	d.prio.add("hi")
+	go dispatch("lo")
+	go dispatch("hi")
+	time.Sleep(200 * time.Millisecond)
+
+	<-j.q.ch
+	var order []string
+	for range 2 * num {
+		select {
+		case task := <-j.q.ch:
+			order = append(order, task.jobID())
+			time.Sleep(10 * time.Millisecond) // (let the dispatchers contend for the slot)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("stuck: %v", order)
+		}
+	}
+	for i, id := range order {
+		expected := "hi"
+		if i >= num {
+			expected = "lo"
+		}
+		tassert.Errorf(t, id == expected, "expecting all hi tasks first, got %v", order)
+	}
+}
@@ -0,0 +1,176 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Resuming after crash (or restart):
+// - the start request of each job is persisted (see downloaderJobs) for as long as the job
+//   is neither finished nor aborted; interrupted jobs (see Xact.Shutdown) retain it as well
+// - upon restart, newInfoStore reloads the records and reconciles each with its finished
+//   items (see persistTaskInfo); the target, once it has joined the cluster, re-enqueues
+//   them under the original job and xaction IDs (see PendingJobs and ResumeJob)
+// - items previously recorded as finished are skipped without any I/O (SkipResumed);
+//   the rest go through the regular DiffResolver which, in turn, skips objects already
+//   present in the cluster and equal to the source (SkipUpToDate)
+// - items finished shortly before the crash (and not yet flushed) get re-verified
+// - previously recorded errors are discarded: failed items are retried
+// - sync jobs (BackendBody.Sync) are resumed from scratch (the resolver needs all names)
+// - credentials are never persisted: source and webhook headers that look like ones
+//   (see isSecretHeader) are redacted prior to writing the record; jobs that had them
+//   cannot be resumed and get dropped upon restart (see reconcile)
+
+type JobRec struct {
+	Started time.Time           `json:"started"`
+	Body    Body                `json:"body"`
+	ID      string              `json:"id"`
+	Xid     string              `json:"xid"`
+	Bck     cmn.Bck             `json:"bck"`
+	Secret  bool                `json:"secret,omitempty"` // credential-bearing headers redacted (cannot resume)
+	done    map[string]struct{} // finished items (see reconcile)
+}
+
+// jobs to resume (and, subsequently, re-enqueue via ResumeJob); once upon restart
+func PendingJobs() (recs []*JobRec) {
+	if g.db == nil {
+		return nil
+	}
+	initStore()
+	g.store.Lock()
+	recs, g.store.pending = g.store.pending, nil
+	g.store.Unlock()
+	return recs
+}
+
+// (see PendingJobs)
+func ResumeJob(bck *meta.Bck, rec *JobRec, xdl *Xact, notif *NotifDownload) (jobif, error) {
+	job, err := ParseStartRequest(bck, rec.ID, rec.Body, xdl)
+	if err != nil {
+		DropJob(rec)
+		return nil, err
+	}
+	job.AddNotif(notif, job)
+	g.store.delErrors(rec.ID)
+	if !job.Sync() {
+		job.base().done = rec.done
+	}
+	if total := job.Len(); total >= 0 {
+		nlog.Infoln("resuming", job.String(), "[ total:", total, "remaining:", max(total-len(rec.done), 0), "]")
+	} else {
+		nlog.Infoln("resuming", job.String(), "[ finished:", len(rec.done), "]")
+	}
+	return job, nil
+}
+
+// cannot be resumed (e.g., the bucket no longer exists)
+func DropJob(rec *JobRec) { g.store.delete(rec.ID) }
+
+// (see newInfoStore)
+func (is *infoStore) reconcile() {
+	if is.driver == nil {
+		return
+	}
+	recs, code, err := is.driver.GetAll(downloaderCollection, is.key(downloaderJobs, "")+"/")
+	if err != nil {
+		if !cos.IsNotExist(err) {
+			nlog.Errorln("downloader: failed to load job records:", err, code)
+		}
+		return
+	}
+	for key, val := range recs {
+		rec := &JobRec{}
+		if err := cos.JSON.UnmarshalFromString(val, rec); err != nil {
+			nlog.Errorln("downloader: invalid job record", key+":", err)
+			is.driver.Delete(downloaderCollection, key)
+			continue
+		}
+		if rec.Secret {
+			nlog.Warningln("downloader: cannot resume job", rec.ID, "- its credentials (headers) were not persisted")
+			is.delete(rec.ID)
+			continue
+		}
+		tasks, err := is.tasks(rec.ID)
+		if err != nil {
+			continue
+		}
+		rec.done = make(map[string]struct{}, len(tasks))
+		for i := range tasks {
+			rec.done[tasks[i].Name] = struct{}{}
+		}
+		is.pending = append(is.pending, rec)
+	}
+	if len(is.pending) > 0 {
+		nlog.Infoln("downloader:", len(is.pending), "job(s) to resume")
+	}
+}
+
+// (see Xact.Download)
+func (is *infoStore) persistJob(job jobif) {
+	b := job.base()
+	if b.body == nil {
+		return
+	}
+	rec := &JobRec{Started: time.Now(), ID: job.ID(), Xid: job.XactID(), Bck: *job.Bck()}
+	rec.Body, rec.Secret = redactBody(b.body)
+	if code, err := is.driver.Set(downloaderCollection, is.key(downloaderJobs, rec.ID), rec); err != nil {
+		nlog.Errorln("downloader: failed to persist", job.String()+":", err, code)
+	}
+}
+
+// start request sans credentials (see JobRec.Secret)
+func redactBody(body *Body) (Body, bool) {
+	var (
+		base Base
+		m    map[string]jsoniter.RawMessage
+	)
+	if jsoniter.Unmarshal(body.RawMessage, &base) != nil || jsoniter.Unmarshal(body.RawMessage, &m) != nil {
+		return Body{Type: body.Type}, true // (unlikely: parsed upon start)
+	}
+	secret := hasSecretHeaders(base.Headers)
+	if base.Webhook != nil && hasSecretHeaders(base.Webhook.Headers) {
+		base.Webhook.Headers = redactHeaders(base.Webhook.Headers)
+		secret = true
+	}
+	if !secret {
+		return *body, false
+	}
+	// (field names are case-insensitive when parsed)
+	for k := range m {
+		if strings.EqualFold(k, "headers") || strings.EqualFold(k, "webhook") {
+			delete(m, k)
+		}
+	}
+	if hdr := redactHeaders(base.Headers); hdr != nil {
+		m["headers"], _ = jsoniter.Marshal(hdr)
+	}
+	if base.Webhook != nil {
+		m["webhook"], _ = jsoniter.Marshal(base.Webhook)
+	}
+	raw, err := jsoniter.Marshal(m)
+	if err != nil {
+		return Body{Type: body.Type}, true
+	}
+	return Body{Type: body.Type, RawMessage: raw}, true
+}
+
+func (is *infoStore) delJobRec(id string) {
+	is.driver.Delete(downloaderCollection, is.key(downloaderJobs, id))
+}
+
+// resumed (see dispatcher.push)
+func (j *baseDlJob) finished(objName string) bool {
+	_, ok := j.done[objName]
+	return ok
+}
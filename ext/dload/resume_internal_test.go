@@ -0,0 +1,195 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// parse and persist the start request (as Xact.Download does)
+func testPersistJob(t *testing.T, bck *meta.Bck, xdl *Xact, id string) {
+	body := Body{Type: TypeRange, RawMessage: []byte(`{"bucket": {"name": "bck"}, "template": "http://host/o{1..5}"}`)}
+	job, err := ParseStartRequest(bck, id, body, xdl)
+	tassert.CheckFatal(t, err)
+	g.store.persistJob(job)
+	g.store.dljobs[id].xid = xdl.ID()
+}
+
+// job records as reloaded upon restart
+func testJobRecs() map[string]*JobRec {
+	is := &infoStore{downloaderDB: newDownloadDB(g.store.driver, ""), dljobs: make(map[string]*dljob)}
+	is.reconcile()
+	recs := make(map[string]*JobRec, len(is.pending))
+	for _, rec := range is.pending {
+		recs[rec.ID] = rec
+	}
+	return recs
+}
+
+func testXact() *Xact {
+	xdl := &Xact{}
+	xdl.InitBase(cos.GenUUID(), apc.ActDownload, nil)
+	return xdl
+}
+
+func TestJobRecLifecycle(t *testing.T) {
+	var (
+		bck = testTarget(t)
+		xdl = testXact()
+	)
+	testStore(t, map[string]int{"run": 0, "fin": 0, "abrt": 0, "intr": 0})
+	for id := range g.store.dljobs {
+		testPersistJob(t, bck, xdl, id)
+	}
+	_, err := g.store.driver.SetString(downloaderCollection, g.store.key(downloaderJobs, "bad"), "{not json")
+	tassert.CheckFatal(t, err)
+
+	// invalid records get dropped
+	recs := testJobRecs()
+	tassert.Fatalf(t, len(recs) == 4, "expecting 4 job records, got %d", len(recs))
+	_, _, err = g.store.driver.GetString(downloaderCollection, g.store.key(downloaderJobs, "bad"))
+	tassert.Errorf(t, cos.IsNotExist(err), "expecting invalid record deleted, got %v", err)
+
+	rec := recs["run"]
+	tassert.Errorf(t, rec.Xid == xdl.ID() && rec.Bck.Name == "bck" && rec.Body.Type == TypeRange && !rec.Started.IsZero(),
+		"unexpected job record %+v", rec)
+	tassert.Errorf(t, len(rec.done) == 0, "expecting nothing done, got %v", rec.done)
+
+	// terminal jobs (finished or aborted) are not to be resumed; interrupted are
+	g.store.setAborted("abrt")
+	g.store.setInterrupted("intr")
+	for _, id := range []string{"fin", "abrt", "intr"} {
+		g.store.markFinished(id)
+	}
+	recs = testJobRecs()
+	tassert.Errorf(t, len(recs) == 2 && recs["run"] != nil && recs["intr"] != nil, "expecting [run intr] to resume, got %v", recs)
+}
+
+func TestReconcile(t *testing.T) {
+	var (
+		bck = testTarget(t)
+		xdl = testXact()
+	)
+	testStore(t, map[string]int{"job": 0})
+	testPersistJob(t, bck, xdl, "job")
+
+	// finished items: flushed and not yet flushed (see Xact.Shutdown)
+	g.store.taskInfoCache["job"] = []TaskDlInfo{{Name: "o1"}, {Name: "o2"}}
+	tassert.CheckFatal(t, g.store.flush("job"))
+	g.store.taskInfoCache["job"] = []TaskDlInfo{{Name: "o4"}}
+	g.store.interrupt(xdl.ID())
+
+	rec := testJobRecs()["job"]
+	tassert.Fatalf(t, rec != nil, "expecting job to resume")
+	tassert.Errorf(t, len(rec.done) == 3, "expecting 3 finished items, got %v", rec.done)
+	for _, name := range []string{"o1", "o2", "o4"} {
+		_, ok := rec.done[name]
+		tassert.Errorf(t, ok, "expecting %q finished", name)
+	}
+
+	// no kvdb, nothing to reconcile
+	is := &infoStore{downloaderDB: &downloaderDB{}, dljobs: make(map[string]*dljob)}
+	is.reconcile()
+	tassert.Errorf(t, len(is.pending) == 0, "not expecting jobs to resume")
+}
+
+func TestResumeJob(t *testing.T) {
+	var (
+		bck = testTarget(t)
+		xdl = testXact()
+	)
+	testStore(t, map[string]int{"job": 0})
+	testPersistJob(t, bck, xdl, "job")
+	g.store.persistError("job", "o3", "connection refused")
+	g.store.taskInfoCache["job"] = []TaskDlInfo{{Name: "o1"}, {Name: "o2"}}
+	g.store.interrupt(xdl.ID())
+
+	// restart
+	savedDB := g.db
+	g.db = g.store.driver
+	t.Cleanup(func() { g.db = savedDB })
+	g.once.Do(func() {}) // (the store is already there - see testStore)
+	g.store.reconcile()
+
+	recs := PendingJobs()
+	tassert.Fatalf(t, len(recs) == 1 && recs[0].ID == "job", "expecting [job] to resume, got %+v", recs)
+	tassert.Errorf(t, len(PendingJobs()) == 0, "expecting pending jobs only once")
+
+	notif := &NotifDownload{Base: nl.Base{When: core.UponTerm, F: func(core.Notif, error, bool) {}}}
+	job, err := ResumeJob(bck, recs[0], testXact(), notif)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, job.ID() == "job" && job.Len() == 5, "expecting the original job with 5 items, got %s (%d)", job, job.Len())
+	b := job.base()
+	tassert.Errorf(t, b.finished("o1") && b.finished("o2") && !b.finished("o3"), "expecting [o1 o2] finished, got %v", b.done)
+	errs, err := g.store.getErrors("job")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(errs) == 0, "expecting previous errors discarded (to retry), got %v", errs)
+
+	// cannot be resumed
+	rec := &JobRec{ID: "job", Body: Body{Type: TypeRange, RawMessage: []byte(`{"template": ""}`)}}
+	_, err = ResumeJob(bck, rec, testXact(), notif)
+	tassert.Errorf(t, err != nil, "expecting invalid start request")
+	_, ok := testJobRecs()["job"]
+	tassert.Errorf(t, !ok, "expecting dropped job record")
+
+	// no kvdb
+	g.db = nil
+	tassert.Errorf(t, PendingJobs() == nil, "not expecting jobs to resume")
+}
+
+func TestJobRecSecrets(t *testing.T) {
+	const secret = "s3cr3t"
+	var (
+		bck = testTarget(t)
+		xdl = testXact()
+	)
+	testStore(t, map[string]int{"job": 0, "plain": 0})
+	body := Body{Type: TypeRange, RawMessage: []byte(`{"bucket": {"name": "bck"}, "template": "http://host/o{1..5}", ` +
+		`"headers": {"Authorization": ["Bearer ` + secret + `"], "X-Request-Id": ["req-1"]}, ` +
+		`"webhook": {"url": "http://hook/done", "headers": {"X-Api-Key": ["` + secret + `"]}}}`)}
+	job, err := ParseStartRequest(bck, "job", body, xdl)
+	tassert.CheckFatal(t, err)
+	g.store.persistJob(job)
+	tassert.Errorf(t, job.Headers().Get("Authorization") == "Bearer "+secret, "expecting in-memory headers intact")
+	testPersistJob(t, bck, xdl, "plain")
+
+	// nothing secret on disk
+	raw, _, err := g.store.driver.GetString(downloaderCollection, g.store.key(downloaderJobs, "job"))
+	tassert.CheckFatal(t, err)
+	rec := &JobRec{}
+	tassert.CheckFatal(t, cos.JSON.UnmarshalFromString(raw, rec))
+	tassert.Errorf(t, rec.Secret, "expecting record marked as redacted")
+	for _, s := range []string{raw, string(rec.Body.RawMessage)} {
+		tassert.Errorf(t, !strings.Contains(s, secret), "expecting no secrets persisted, got %s", s)
+	}
+	rb := &RangeBody{}
+	tassert.CheckFatal(t, jsoniter.Unmarshal(rec.Body.RawMessage, rb))
+	tassert.Errorf(t, rb.Headers.Get("X-Request-Id") == "req-1" && rb.Headers.Get("Authorization") == hookRedacted,
+		"expecting redacted headers, got %v", rb.Headers)
+	tassert.Errorf(t, rb.Webhook != nil && rb.Webhook.URL == "http://hook/done" && rb.Webhook.Headers.Get("X-Api-Key") == hookRedacted,
+		"expecting redacted webhook, got %+v", rb.Webhook)
+	tassert.Errorf(t, rb.Template == "http://host/o{1..5}", "expecting the rest of the request intact, got %+v", rb)
+
+	// (case-insensitive field names)
+	body.RawMessage = []byte(`{"template": "http://host/o{1..5}", "Headers": {"X-Auth-Token": ["` + secret + `"]}}`)
+	redacted, ok := redactBody(&body)
+	tassert.Errorf(t, ok && !strings.Contains(string(redacted.RawMessage), secret), "expecting redacted, got %s", redacted.RawMessage)
+
+	// cannot resume without credentials: dropped upon restart
+	recs := testJobRecs()
+	tassert.Errorf(t, len(recs) == 1 && recs["plain"] != nil && !recs["plain"].Secret, "expecting [plain] to resume, got %v", recs)
+	_, _, err = g.store.driver.GetString(downloaderCollection, g.store.key(downloaderJobs, "job"))
+	tassert.Errorf(t, cos.IsNotExist(err), "expecting dropped job record, got %v", err)
+}
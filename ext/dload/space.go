@@ -0,0 +1,67 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Disk-space guard (see Base.MinFreePct)
+//
+// Before writing each item, the jogger checks free space on its mountpath; when below
+// the job's threshold, the job (on this mountpath) waits rather than filling the disk -
+// re-checking every spaceRecheck until space frees up, the job gets aborted, or the
+// downloader stops. Job.SpaceWait reports the state.
+// Note: the check is a fresh statfs (cheap next to downloading).
+
+const spaceRecheck = 10 * time.Second
+
+// free space (percentage) is at or above the threshold;
+// statfs failure does not block (FSHC is elsewhere)
+func spaceOK(mpath string, minFreePct int) bool {
+	blocks, bavail, _, err := ios.GetFSStats(mpath)
+	if err != nil || blocks == 0 {
+		return true
+	}
+	return bavail*100/blocks >= uint64(minFreePct)
+}
+
+// returns upon (enough) space, abort, or stop
+func (j *jogger) waitSpace(t *singleTask) {
+	pct := t.job.MinFreePct()
+	if pct == 0 || spaceOK(j.mpath, pct) {
+		return
+	}
+	var (
+		id      = t.jobID()
+		abortCh = j.parent.jobAbortedCh(id)
+		ticker  = time.NewTicker(spaceRecheck)
+	)
+	nlog.Warningf("%s: %s is below %d%% free space - pausing download job %q", core.T, j.mpath, pct, id)
+	g.store.incSpaceWait(id, 1)
+	defer func() {
+		ticker.Stop()
+		g.store.incSpaceWait(id, -1)
+	}()
+	for {
+		select {
+		case <-ticker.C:
+			if spaceOK(j.mpath, pct) {
+				nlog.Infof("%s: %s: resuming download job %q", core.T, j.mpath, id)
+				return
+			}
+		case <-abortCh.Listen():
+			return
+		case <-j.parent.stopCh.Listen():
+			return
+		case <-j.parent.drainCh.Listen():
+			return
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSpaceOK(t *testing.T) {
+	dir := t.TempDir()
+	tassert.Errorf(t, spaceOK(dir, 0), "expecting ok with no threshold")
+	tassert.Errorf(t, spaceOK(dir, 1), "expecting at least 1%% free in %s", dir)
+	tassert.Errorf(t, !spaceOK(dir, 100), "not expecting 100%% free in %s", dir)
+	tassert.Errorf(t, spaceOK("/no/such/mpath", 100), "statfs failure must not block")
+}
+
+func TestMinFreePctValidate(t *testing.T) {
+	for pct, valid := range map[int]bool{0: true, 10: true, 99: true, 100: false, -1: false} {
+		err := (&Base{Bck: cmn.Bck{Name: "bck"}, MinFreePct: pct}).Validate()
+		tassert.Errorf(t, (err == nil) == valid, "%d: valid=%t, got %v", pct, valid, err)
+	}
+}
+
+func TestWaitSpace(t *testing.T) {
+	const jobID = "job"
+	testStore(t, map[string]int{jobID: 0})
+	for _, stop := range []string{"abort", "stop", "drain"} {
+		t.Run(stop, func(t *testing.T) {
+			var (
+				d = &dispatcher{
+					stopCh:   cos.NewStopCh(),
+					drainCh:  cos.NewStopCh(),
+					abortJob: map[string]*cos.StopCh{jobID: cos.NewStopCh()},
+				}
+				j    = newJogger(d, t.TempDir())
+				task = &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: jobID, minFreePct: 100}}}
+				done = make(chan struct{})
+			)
+			go func() {
+				j.waitSpace(task)
+				close(done)
+			}()
+			deadline := time.Now().Add(5 * time.Second)
+			for !g.store.dljobs[jobID].clone().SpaceWait && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+			tassert.Fatalf(t, g.store.dljobs[jobID].clone().SpaceWait, "expecting the job to wait for space")
+
+			switch stop {
+			case "abort":
+				d.abortJob[jobID].Close()
+			case "stop":
+				d.stopCh.Close()
+			default:
+				d.drainCh.Close()
+			}
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: waitSpace did not return", stop)
+			}
+			tassert.Errorf(t, !g.store.dljobs[jobID].clone().SpaceWait, "expecting no longer waiting")
+		})
+	}
+
+	// enough space: no waiting
+	d := &dispatcher{stopCh: cos.NewStopCh(), drainCh: cos.NewStopCh()}
+	task := &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: jobID, minFreePct: 1}}}
+	newJogger(d, t.TempDir()).waitSpace(task)
+	tassert.Errorf(t, !g.store.dljobs[jobID].clone().SpaceWait, "not expecting to wait")
+}
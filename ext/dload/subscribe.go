@@ -0,0 +1,159 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// pull-based progress stream (see Subscribe)
+// - a snapshot is emitted upon every counter change (see infoStore.inc*), abort, and interruption
+// - producers never block: when the (bounded) channel is full the oldest snapshot gets dropped
+
+const subChanCap = 64
+
+type (
+	DlProgress struct {
+		Time         time.Time     `json:"time"`
+		ID           string        `json:"id"`
+		ScheduledCnt int           `json:"scheduled_cnt"`
+		FinishedCnt  int           `json:"finished_cnt"`
+		SkippedCnt   int           `json:"skipped_cnt"`
+		ErrorCnt     int           `json:"error_cnt"`
+		Total        int           `json:"total"` // negative if unknown
+		Bytes        int64         `json:"bytes,string"`
+		ETA          time.Duration `json:"eta,omitempty"` // zero if unknown
+		Finished     bool          `json:"finished,omitempty"`
+	}
+
+	// final state of a job, as returned by WaitForTerminal
+	DlJobSnapshot = Job
+
+	subscriber struct {
+		ch chan DlProgress
+	}
+	subscribers struct {
+		m  map[string][]*subscriber // job ID => subscribers
+		mu sync.RWMutex
+	}
+)
+
+var subs = subscribers{m: make(map[string][]*subscriber, 4)}
+
+// Subscribe returns a channel of progress snapshots of a given job, and a function to
+// unsubscribe (the latter closes the channel)
+func Subscribe(id string) (<-chan DlProgress, func()) {
+	s := &subscriber{ch: make(chan DlProgress, subChanCap)}
+	subs.mu.Lock()
+	subs.m[id] = append(subs.m[id], s)
+	subs.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() { subs.del(id, s) })
+	}
+	return s.ch, cancel
+}
+
+// WaitForTerminal blocks until the job (on this target) is finished, aborted, or interrupted -
+// or until `ctx` is done - and returns the job's snapshot.
+// No polling: woken up by the same notifications that drive Subscribe (terminal transitions included).
+func WaitForTerminal(ctx context.Context, id string) (DlJobSnapshot, error) {
+	if g.store == nil {
+		return DlJobSnapshot{}, errJobNotFound
+	}
+	ch, unsub := Subscribe(id) // before checking - no lost wakeups
+	defer unsub()
+	for {
+		dljob, err := g.store.getJob(id)
+		if err != nil {
+			return DlJobSnapshot{}, err
+		}
+		if dljob.terminal() {
+			return dljob.clone(), nil
+		}
+		select {
+		case <-ctx.Done():
+			return dljob.clone(), ctx.Err()
+		case <-ch:
+		}
+	}
+}
+
+func (j *dljob) terminal() bool {
+	return j.aborted.Load() || j.interrupted.Load() || !_isRunning(j.finishedTime.Load())
+}
+
+func (ss *subscribers) del(id string, s *subscriber) {
+	ss.mu.Lock()
+	l := ss.m[id]
+	for i := range l {
+		if l[i] == s {
+			l = append(l[:i], l[i+1:]...)
+			break
+		}
+	}
+	if len(l) == 0 {
+		delete(ss.m, id)
+	} else {
+		ss.m[id] = l
+	}
+	close(s.ch)
+	ss.mu.Unlock()
+}
+
+func (ss *subscribers) notify(j *dljob) {
+	ss.mu.RLock()
+	l := ss.m[j.id]
+	if len(l) == 0 {
+		ss.mu.RUnlock()
+		return
+	}
+	p := j.progress()
+	for _, s := range l {
+		s.send(p)
+	}
+	ss.mu.RUnlock()
+}
+
+// never blocks: drop the oldest
+func (s *subscriber) send(p DlProgress) {
+	for {
+		select {
+		case s.ch <- p:
+			return
+		default:
+		}
+		select {
+		case <-s.ch:
+		default:
+		}
+	}
+}
+
+func (j *dljob) progress() DlProgress {
+	var (
+		now = time.Now()
+		p   = DlProgress{
+			Time:         now,
+			ID:           j.id,
+			ScheduledCnt: int(j.scheduledCnt.Load()),
+			FinishedCnt:  int(j.finishedCnt.Load()),
+			SkippedCnt:   int(j.skippedCnt.Load()),
+			ErrorCnt:     int(j.errorCnt.Load()),
+			Total:        int(j.total.Load()),
+			Bytes:        j.bytes.Load(),
+			Finished:     !_isRunning(j.finishedTime.Load()),
+		}
+		done = p.FinishedCnt + p.ErrorCnt
+	)
+	if !p.Finished && p.Total > 0 && done > 0 && done < p.Total {
+		elapsed := now.Sub(j.startedTime)
+		p.ETA = time.Duration(int64(elapsed) / int64(done) * int64(p.Total-done))
+	}
+	return p
+}
@@ -0,0 +1,195 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type waitResult struct {
+	snap DlJobSnapshot
+	err  error
+}
+
+func testWait(ctx context.Context, id string) <-chan waitResult {
+	ch := make(chan waitResult, 1)
+	go func() {
+		snap, err := WaitForTerminal(ctx, id)
+		ch <- waitResult{snap, err}
+	}()
+	return ch
+}
+
+func testWaitDone(t *testing.T, ch <-chan waitResult, what string) waitResult {
+	t.Helper()
+	select {
+	case res := <-ch:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s: WaitForTerminal did not return", what)
+	}
+	return waitResult{}
+}
+
+func testWaitBlocked(t *testing.T, ch <-chan waitResult, what string) {
+	t.Helper()
+	select {
+	case res := <-ch:
+		t.Fatalf("%s: not expecting WaitForTerminal to return (%+v, %v)", what, res.snap, res.err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWaitForTerminal(t *testing.T) {
+	testStore(t, map[string]int{"fin": 0, "abrt": 0, "intr": 0})
+
+	t.Run("finished", func(t *testing.T) {
+		g.store.dljobs["fin"].finishedCnt.Store(3)
+		ch := testWait(context.Background(), "fin")
+		testWaitBlocked(t, ch, "running")
+
+		g.store.incFinished("fin") // (progress is not terminal)
+		testWaitBlocked(t, ch, "progress")
+
+		err, aborted := g.store.markFinished("fin")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, !aborted, "not expecting aborted")
+		res := testWaitDone(t, ch, "finished")
+		tassert.CheckFatal(t, res.err)
+		tassert.Errorf(t, !res.snap.FinishedTime.IsZero() && res.snap.FinishedCnt == 4,
+			"expecting finished with 4 objects, got %+v", res.snap)
+	})
+	t.Run("aborted", func(t *testing.T) {
+		ch := testWait(context.Background(), "abrt")
+		testWaitBlocked(t, ch, "running")
+		g.store.setAborted("abrt")
+		res := testWaitDone(t, ch, "aborted")
+		tassert.CheckFatal(t, res.err)
+		tassert.Errorf(t, res.snap.Aborted, "expecting aborted, got %+v", res.snap)
+	})
+	t.Run("interrupted", func(t *testing.T) {
+		ch := testWait(context.Background(), "intr")
+		testWaitBlocked(t, ch, "running")
+		g.store.setInterrupted("intr")
+		res := testWaitDone(t, ch, "interrupted")
+		tassert.CheckFatal(t, res.err)
+		tassert.Errorf(t, res.snap.Interrupted, "expecting interrupted, got %+v", res.snap)
+	})
+	t.Run("already-terminal", func(t *testing.T) {
+		// all of the above, once again - must return right away
+		for _, id := range []string{"fin", "abrt", "intr"} {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			snap, err := WaitForTerminal(ctx, id)
+			cancel()
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, snap.ID == id, "expecting %q, got %+v", id, snap)
+		}
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, err := WaitForTerminal(context.Background(), "no-such-job")
+		tassert.Errorf(t, errors.Is(err, errJobNotFound), "expecting not-found, got %v", err)
+	})
+}
+
+func TestWaitForTerminalCtx(t *testing.T) {
+	testStore(t, map[string]int{"job": 0})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := testWait(ctx, "job")
+	testWaitBlocked(t, ch, "running")
+	cancel()
+	res := testWaitDone(t, ch, "canceled")
+	tassert.Errorf(t, errors.Is(res.err, context.Canceled), "expecting canceled, got %v", res.err)
+	tassert.Errorf(t, res.snap.ID == "job" && res.snap.JobRunning(), "expecting running snapshot, got %+v", res.snap)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := WaitForTerminal(ctx, "job")
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expecting deadline exceeded, got %v", err)
+
+	// no leaked subscriptions
+	subs.mu.RLock()
+	n := len(subs.m["job"])
+	subs.mu.RUnlock()
+	tassert.Errorf(t, n == 0, "expecting no subscribers, got %d", n)
+}
+
+func TestSubscribe(t *testing.T) {
+	const num = subChanCap + 10
+	testStore(t, map[string]int{"job": 0})
+
+	ch, unsub := Subscribe("job")
+	ch2, unsub2 := Subscribe("job")
+	defer unsub2()
+
+	// never blocks the producer; the oldest get dropped
+	for range num {
+		g.store.incFinished("job")
+	}
+	tassert.Fatalf(t, len(ch) == subChanCap, "expecting %d queued, got %d", subChanCap, len(ch))
+	first := <-ch
+	tassert.Errorf(t, first.ID == "job" && first.FinishedCnt == num-subChanCap+1, "expecting oldest retained %d, got %+v",
+		num-subChanCap+1, first)
+	var last DlProgress
+	for len(ch) > 0 {
+		last = <-ch
+	}
+	tassert.Errorf(t, last.FinishedCnt == num, "expecting latest %d, got %+v", num, last)
+
+	// unsubscribe closes the channel (once) and leaves the others alone
+	unsub()
+	unsub()
+	_, ok := <-ch
+	tassert.Errorf(t, !ok, "expecting closed channel")
+	g.store.incFinished("job")
+	tassert.Errorf(t, len(ch2) == subChanCap, "expecting the other subscriber to keep receiving")
+
+	subs.mu.RLock()
+	n := len(subs.m["job"])
+	subs.mu.RUnlock()
+	tassert.Errorf(t, n == 1, "expecting 1 subscriber, got %d", n)
+}
+
+func TestProgressETA(t *testing.T) {
+	testStore(t, map[string]int{"job": 0})
+	dljob := g.store.dljobs["job"]
+	dljob.startedTime = time.Now().Add(-10 * time.Second)
+	dljob.total.Store(10)
+
+	p := dljob.progress()
+	tassert.Errorf(t, p.ETA == 0, "nothing done: expecting unknown ETA, got %v", p.ETA)
+
+	dljob.finishedCnt.Store(4)
+	dljob.errorCnt.Store(1)
+	p = dljob.progress()
+	tassert.Errorf(t, p.ETA > 9*time.Second && p.ETA < 11*time.Second, "half done in 10s: expecting ETA ~10s, got %v", p.ETA)
+
+	dljob.finishedTime.Store(time.Now())
+	p = dljob.progress()
+	tassert.Errorf(t, p.Finished && p.ETA == 0, "finished: expecting no ETA, got %+v", p)
+}
+
+func TestWaitValidate(t *testing.T) {
+	tests := []struct {
+		body  AdminBody
+		valid bool
+	}{
+		{AdminBody{ID: "id", Wait: time.Second}, true},
+		{AdminBody{ID: "id", Wait: MaxStatusWait}, true},
+		{AdminBody{ID: "id", Wait: MaxStatusWait + time.Second}, false},
+		{AdminBody{ID: "id", Wait: -time.Second}, false},
+		{AdminBody{Wait: time.Second}, false},
+		{AdminBody{Regex: "^x", Wait: time.Second}, false},
+	}
+	for _, test := range tests {
+		err := test.body.Validate(false)
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, got %v", test.body, test.valid, err)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -32,6 +34,12 @@ const (
 	internalErrorMsg = "internal server error"
 )
 
+// watchdog (see dispatcher.watchdog)
+const (
+	stuckWarnAfter = 5 * time.Minute
+	watchdogIval   = time.Minute
+)
+
 type singleTask struct {
 	xdl         *Xact
 	job         jobif
@@ -43,18 +51,25 @@ type singleTask struct {
 	downloadCtx context.Context    // w/ cancel function
 	getCtx      context.Context    // w/ timeout and size
 	cancel      context.CancelFunc // to cancel in-progress download
+	stuck       atomic.Bool        // logged by the watchdog (once)
+	cancelled   atomic.Bool        // see cancel.go
+	numChunks   atomic.Int32       // chunked write: total number of chunks (see chunked.go)
+	chunksDone  atomic.Int32       // ditto: written so far
+	chunked     *dlChunked         // chunked write in progress (nil otherwise)
+	exists      bool               // destination object exists (enables conditional GET)
 }
 
 // List of HTTP status codes which we shouldn'task retry (just report the job failed).
 var terminalStatuses = map[int]struct{}{
-	http.StatusNotFound:          {},
-	http.StatusPaymentRequired:   {},
-	http.StatusUnauthorized:      {},
-	http.StatusForbidden:         {},
-	http.StatusMethodNotAllowed:  {},
-	http.StatusNotAcceptable:     {},
-	http.StatusProxyAuthRequired: {},
-	http.StatusGone:              {},
+	http.StatusNotFound:                     {},
+	http.StatusPaymentRequired:              {},
+	http.StatusUnauthorized:                 {},
+	http.StatusForbidden:                    {},
+	http.StatusMethodNotAllowed:             {},
+	http.StatusNotAcceptable:                {},
+	http.StatusProxyAuthRequired:            {},
+	http.StatusGone:                         {},
+	http.StatusRequestedRangeNotSatisfiable: {}, // see byterange.go
 }
 
 ////////////////
@@ -71,15 +86,31 @@ func (task *singleTask) download(lom *core.LOM) {
 	if err == nil {
 		err = lom.Load(true /*cache it*/, false /*locked*/)
 	}
+	if task.job.VerifyOnly() {
+		if err = task.verify(lom, err); err != nil {
+			task.markFailed(err.Error())
+		} else {
+			g.store.incFinished(task.jobID())
+		}
+		return
+	}
 	if err != nil && !cos.IsNotExist(err) {
 		task.markFailed(internalErrorMsg)
 		return
 	}
+	task.exists = err == nil
 
 	if cmn.Rom.V(4, cos.ModDload) {
 		nlog.Infof("Starting download for %v", task)
 	}
 
+	// per-item timeout (all retries included)
+	if itd := task.job.ItemTimeout(); itd > 0 {
+		var cancel context.CancelFunc
+		task.downloadCtx, cancel = context.WithTimeout(task.downloadCtx, itd)
+		defer cancel()
+	}
+
 	task.started.Store(time.Now())
 	lom.SetAtimeUnix(task.started.Load().UnixNano())
 	// 3 types of downloads: ETL, remote, local
@@ -93,23 +124,48 @@ func (task *singleTask) download(lom *core.LOM) {
 	}
 	task.ended.Store(time.Now())
 
+	if errors.Is(err, errNotModified) {
+		g.store.incSkipped(task.jobID(), SkipNotModified)
+		g.store.addLeaf(task.jobID(), lom, task.obj.rng)
+		return
+	}
 	if err != nil {
+		task.abortChunked(lom)
+		if task.cancelled.Load() {
+			g.store.incCancelled(task.jobID())
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) && task.itemExpired() {
+			err = fmt.Errorf("item timeout (%v) exceeded: %w", task.job.ItemTimeout(), err)
+		}
 		task.markFailed(err.Error())
 		return
 	}
 
+	lsize := task.currentSize.Load()
+	g.store.addBytes(task.jobID(), lsize)
 	g.store.incFinished(task.jobID())
+	g.store.addLeaf(task.jobID(), lom, task.obj.rng)
 
 	vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
-	lsize := task.currentSize.Load()
 	core.T.StatsUpdater().AddWith(
 		cos.NamedVal64{Name: stats.DloadSize, Value: lsize, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.DloadLatencyTotal, Value: int64(task.ended.Load().Sub(task.started.Load())), VarLabs: vlabs},
 	)
 	task.xdl.ObjsAdd(1, lsize)
+	if p := task.job.Parent(); p != nil {
+		p.ObjsAdd(1, lsize)
+	}
 }
 
 func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*err is fatal*/, error) {
+	// politeness wait (if any) does not count against the request timeout
+	pol := task.job.polite()
+	ph, err := pol.acquire(task.downloadCtx, linkHost(task.obj.link))
+	if err != nil {
+		return false, err
+	}
+
 	ctx, cancel := context.WithTimeout(task.downloadCtx, timeout)
 	defer cancel()
 
@@ -117,11 +173,20 @@ func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*er
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.obj.link, http.NoBody)
 	if err != nil {
+		pol.release(ph, nil)
 		return true, err
 	}
 
 	// Add custom headers, if any
 	cmn.CopyHeaders(req.Header, task.job.Headers())
+	switch {
+	case task.obj.rng != nil:
+		task.obj.rng.setHdr(req.Header)
+	case task.chunked != nil:
+		task.chunked.setRange(req.Header) // resume
+	case task.exists:
+		task.condHeaders(lom, req.Header)
+	}
 
 	// Set "User-Agent" header when doing requests to Google Cloud Storage.
 	// This should increase the number of connections to GCS.
@@ -131,16 +196,38 @@ func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*er
 
 	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
+		pol.release(ph, nil)
 		fatal := errors.Is(err, errBlockedEgress)
 		return fatal, err
 	}
 
 	fatal, err := task._dput(lom, req, resp)
 	cos.Close(resp.Body)
+	pol.release(ph, resp)
 	return fatal, err
 }
 
+// when previously downloaded: If-None-Match and/or If-Modified-Since
+// (using validators stored along with the object - see attrsFromLink)
+func (*singleTask) condHeaders(lom *core.LOM, hdr http.Header) {
+	if hdr.Get(cos.HdrIfNoneMatch) != "" || hdr.Get(cos.HdrIfModifiedSince) != "" {
+		return // user-specified
+	}
+	if etag, ok := lom.GetCustomKey(cmn.ETag); ok && etag != "" {
+		if !strings.HasPrefix(etag, `"`) && !strings.HasPrefix(etag, "W/") {
+			etag = strconv.Quote(etag)
+		}
+		hdr.Set(cos.HdrIfNoneMatch, etag)
+	}
+	if lm, ok := lom.GetCustomKey(cos.HdrLastModified); ok && lm != "" {
+		hdr.Set(cos.HdrIfModifiedSince, lm)
+	}
+}
+
 func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Response) (bool /*err is fatal*/, error) {
+	if resp.StatusCode == http.StatusNotModified {
+		return true, errNotModified
+	}
 	if resp.StatusCode >= http.StatusBadRequest {
 		if resp.StatusCode == http.StatusNotFound {
 			e := cos.NewErrNotFound(nil, task.obj.link)
@@ -151,8 +238,21 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 			resp.StatusCode)
 	}
 
-	r := task.wrapReader(resp.Body)
-	size := attrsFromLink(task.obj.link, resp, lom)
+	var (
+		r    = task.wrapReader(resp.Body)
+		size int64
+	)
+	if task.obj.rng != nil {
+		var err error
+		if size, err = task.obj.rng.check(resp); err != nil {
+			return true, fmt.Errorf("%s [range %s]: %w", task.obj.link, task.obj.rng, err)
+		}
+	} else {
+		size = attrsFromLink(task.obj.link, resp, lom)
+	}
+	if task.chunked != nil || (task.obj.rng == nil && task.chunkable(size)) {
+		return task._dputChunked(lom, resp, r, size)
+	}
 	task.setTotalSize(size)
 
 	params := core.AllocPutParams()
@@ -191,6 +291,10 @@ func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
 			return err // canceled or stopped, so just return
 		}
 		if errors.Is(err, context.DeadlineExceeded) {
+			g.store.incTimeoutCnt(task.jobID())
+			if task.itemExpired() {
+				return err // no more retries
+			}
 			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retryCnt, timeout)
 			timeout = time.Duration(float64(timeout) * reqTimeoutFactor)
 		} else if herr := cmn.AsErrHTTP(err); herr != nil {
@@ -198,6 +302,8 @@ func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
 			if _, exists := terminalStatuses[herr.Status]; exists {
 				return err // nothing we can do
 			}
+		} else if errors.Is(err, errChunkIO) {
+			nlog.Warningf("%s [retries: %d/%d]: %v - resuming", task, i, retryCnt, err)
 		} else {
 			if !cos.IsErrRetriableConn(err) {
 				return err // ditto
@@ -217,7 +323,7 @@ func (task *singleTask) setTotalSize(size int64) {
 
 func (task *singleTask) reset() {
 	task.totalSize.Store(0)
-	task.currentSize.Store(0)
+	task.currentSize.Store(task.chunked.offset()) // (resuming chunked write)
 }
 
 func (task *singleTask) downloadRemote(lom *core.LOM) error {
@@ -231,9 +337,25 @@ func (task *singleTask) downloadRemote(lom *core.LOM) error {
 
 	// Do final GET (prefetch) request.
 	_, err := core.T.GetCold(ctx, lom, task.xdl.Kind(), cmn.OwtGetTryLock)
+	if err != nil && errors.Is(err, context.DeadlineExceeded) {
+		g.store.incTimeoutCnt(task.jobID())
+	}
 	return err
 }
 
+// true when the per-item timeout (if any) has expired
+func (task *singleTask) itemExpired() bool {
+	return task.job.ItemTimeout() > 0 && errors.Is(task.downloadCtx.Err(), context.DeadlineExceeded)
+}
+
+// soft threshold: warn but keep going
+func (task *singleTask) stuckAfter() time.Duration {
+	if itd := task.job.ItemTimeout(); itd > 0 {
+		return min(itd/2, stuckWarnAfter)
+	}
+	return stuckWarnAfter
+}
+
 func (task *singleTask) initialTimeout() time.Duration {
 	config := cmn.GCO.Get()
 	timeout := config.Downloader.Timeout.D()
@@ -262,7 +384,7 @@ func (task *singleTask) wrapReader(r io.ReadCloser) io.ReadCloser {
 func (task *singleTask) markFailed(statusMsg string) {
 	core.T.StatsUpdater().Inc(stats.ErrDloadCount)
 	g.store.persistError(task.jobID(), task.obj.objName, statusMsg)
-	g.store.incErrorCnt(task.jobID())
+	g.store.incErrorCnt(task.jobID(), task.obj.objName, statusMsg)
 }
 
 func (task *singleTask) persist() {
@@ -0,0 +1,84 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestItemTimeoutValidate(t *testing.T) {
+	tests := []struct {
+		itd   string
+		valid bool
+	}{
+		{"", true},
+		{"30s", true},
+		{"0s", true},
+		{"-1s", false},
+		{"abc", false},
+	}
+	for _, test := range tests {
+		b := &Base{Bck: cmn.Bck{Name: "bck"}, ItemTimeout: test.itd}
+		err := b.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "item_timeout %q: valid=%t, got %v", test.itd, test.valid, err)
+	}
+}
+
+func TestStuckAfter(t *testing.T) {
+	tests := []struct {
+		itd, expected time.Duration
+	}{
+		{0, stuckWarnAfter},
+		{2 * time.Minute, time.Minute},
+		{time.Hour, stuckWarnAfter},
+	}
+	for _, test := range tests {
+		task := &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{itemTimeout: test.itd}}}
+		tassert.Errorf(t, task.stuckAfter() == test.expected, "item timeout %v: expecting %v, got %v",
+			test.itd, test.expected, task.stuckAfter())
+	}
+}
+
+func TestItemExpired(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-expired.Done()
+	canceled, cancel2 := context.WithCancel(context.Background())
+	cancel2()
+
+	tests := []struct {
+		ctx      context.Context
+		name     string
+		itd      time.Duration
+		expected bool
+	}{
+		{name: "expired", ctx: expired, itd: time.Second, expected: true},
+		{name: "no-item-timeout", ctx: expired, itd: 0, expected: false},
+		{name: "canceled", ctx: canceled, itd: time.Second, expected: false},
+		{name: "in-progress", ctx: context.Background(), itd: time.Second, expected: false},
+	}
+	for _, test := range tests {
+		task := &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{itemTimeout: test.itd}}, downloadCtx: test.ctx}
+		tassert.Errorf(t, task.itemExpired() == test.expected, "%s: expecting %t", test.name, test.expected)
+	}
+}
+
+func TestTimeoutCnt(t *testing.T) {
+	testStore(t, map[string]int{"job": 0})
+	for range 3 {
+		g.store.incTimeoutCnt("job")
+	}
+	job := g.store.dljobs["job"].clone()
+	tassert.Errorf(t, job.TimeoutCnt == 3, "expecting 3 timeouts, got %d", job.TimeoutCnt)
+
+	// across targets
+	job.Aggregate(&Job{TimeoutCnt: 2})
+	tassert.Errorf(t, job.TimeoutCnt == 5, "expecting 5 timeouts, got %d", job.TimeoutCnt)
+}
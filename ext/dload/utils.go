@@ -36,7 +36,7 @@ func clientForURL(u string) *http.Client {
 }
 
 //nolint:gocritic // need a copy of cos.ParsedTemplate
-func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck) (cnt int, err error) {
+func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck, nt *nameTmpl) (cnt int, err error) {
 	var (
 		smap = core.T.Sowner().Get()
 		sid  = core.T.SID()
@@ -47,6 +47,9 @@ func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck) (cnt int, er
 	// TODO: micro-opt: reuse bucket uname prefix for repeated HRW calls (see e.g. xs/nextpage)
 	for link, ok := pt.Next(); ok; link, ok = pt.Next() {
 		name := path.Join(dir, path.Base(link))
+		if name, err = nt.apply(name, link); err != nil {
+			return
+		}
 		name, err = NormalizeObjName(name)
 		if err != nil {
 			return
@@ -63,7 +66,7 @@ func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck) (cnt int, er
 }
 
 // buildDlObjs returns list of objects that must be downloaded by target.
-func buildDlObjs(bck *meta.Bck, objects cos.StrKVs) ([]dlObj, error) {
+func buildDlObjs(bck *meta.Bck, nt *nameTmpl, objects cos.StrKVs, ranges map[string]*dlRange) ([]dlObj, error) {
 	var (
 		smap = core.T.Sowner().Get()
 		sid  = core.T.SID()
@@ -71,20 +74,25 @@ func buildDlObjs(bck *meta.Bck, objects cos.StrKVs) ([]dlObj, error) {
 
 	objs := make([]dlObj, 0, len(objects))
 	for name, link := range objects {
-		obj, err := makeDlObj(smap, sid, bck, name, link)
+		obj, err := makeDlObj(smap, sid, bck, nt, name, link)
 		if err != nil {
 			if err == errInvalidTarget {
 				continue
 			}
 			return nil, err
 		}
+		obj.rng = ranges[name]
 		objs = append(objs, obj)
 	}
 	return objs, nil
 }
 
-func makeDlObj(smap *meta.Smap, sid string, bck *meta.Bck, objName, link string) (dlObj, error) {
-	objName, err := NormalizeObjName(objName)
+func makeDlObj(smap *meta.Smap, sid string, bck *meta.Bck, nt *nameTmpl, objName, link string) (dlObj, error) {
+	objName, err := nt.apply(objName, link)
+	if err != nil {
+		return dlObj{}, err
+	}
+	objName, err = NormalizeObjName(objName)
 	if err != nil {
 		return dlObj{}, err
 	}
@@ -121,6 +129,15 @@ func NormalizeObjName(objName string) (string, error) {
 }
 
 func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
+	job, err := parseStartRequest(bck, id, dlb, xdl)
+	if err != nil {
+		return nil, err
+	}
+	job.base().body = &dlb // (see resume.go)
+	return job, nil
+}
+
+func parseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
 	switch dlb.Type {
 	case TypeBackend:
 		dp := &BackendBody{}
@@ -162,8 +179,18 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 			return nil, err
 		}
 		return newSingleDlJob(id, bck, dp, xdl)
+	case TypeImport:
+		dp := &ImportBody{}
+		err := jsoniter.Unmarshal(dlb.RawMessage, dp)
+		if err != nil {
+			return nil, err
+		}
+		if err := dp.Validate(); err != nil {
+			return nil, err
+		}
+		return newImportDlJob(id, bck, dp, xdl)
 	default:
-		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend)")
+		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend, import)")
 	}
 }
 
@@ -214,6 +241,13 @@ func attrsFromLink(link string, resp *http.Response, oah cos.OAH) (size int64) {
 		// [TODO] Add case, if necessary, for OCI here
 	default:
 		oah.SetCustomKey(cmn.SourceObjMD, cmn.WebObjMD)
+		// validators for subsequent conditional GET (see singleTask.condHeaders)
+		if v := resp.Header.Get(cos.HdrETag); v != "" {
+			oah.SetCustomKey(cmn.ETag, v)
+		}
+	}
+	if v := resp.Header.Get(cos.HdrLastModified); v != "" {
+		oah.SetCustomKey(cos.HdrLastModified, v)
 	}
 	return resp.ContentLength
 }
@@ -0,0 +1,74 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Verify-only (see Base.VerifyOnly): re-run a job (e.g., a big import) to check that
+// all expected objects exist - without downloading or writing anything:
+// - missing objects count as errors, existing ones as finished
+// - with Base.VerifyJob: additionally, compare size and checksum against the Merkle
+//   manifest recorded by that (earlier) job (see Base.Manifest and manifest.go);
+//   objects not in the manifest count as errors as well
+// - manifests are per target: the comparison presumes the same cluster membership
+
+type refManifest struct {
+	leaves map[string]*MfLeaf
+	err    error
+	once   sync.Once
+}
+
+func (rm *refManifest) load(jobID string) {
+	mft, err := loadManifest(jobID)
+	if err != nil {
+		rm.err = fmt.Errorf("verify: failed to load manifest of the job %q: %w", jobID, err)
+		return
+	}
+	rm.leaves = make(map[string]*MfLeaf, len(mft.Leaves))
+	for i := range mft.Leaves {
+		rm.leaves[mft.Leaves[i].Name] = &mft.Leaves[i]
+	}
+}
+
+// (errLoad: see task.download)
+func (task *singleTask) verify(lom *core.LOM, errLoad error) error {
+	if errLoad != nil {
+		if cos.IsNotExist(errLoad) {
+			return errors.New("verify: object does not exist")
+		}
+		return errLoad
+	}
+	refID := task.job.VerifyJob()
+	if refID == "" {
+		return nil
+	}
+	leaf, err := task.job.refLeaf(lom.ObjName)
+	if err != nil {
+		return err
+	}
+	if leaf == nil {
+		return fmt.Errorf("verify: not recorded in the manifest of the job %q", refID)
+	}
+	if leaf.Size != 0 && leaf.Size != lom.Lsize() {
+		return fmt.Errorf("verify: size mismatch (%d vs %d recorded)", lom.Lsize(), leaf.Size)
+	}
+	if leaf.Cksum != "" {
+		var cksum string
+		if ck := lom.Checksum(); !cos.NoneC(ck) {
+			cksum = ck.Ty() + ":" + ck.Val()
+		}
+		if cksum != leaf.Cksum {
+			return fmt.Errorf("verify: checksum mismatch (%q vs %q recorded)", cksum, leaf.Cksum)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestVerify(t *testing.T) {
+	bck := testMpath(t)
+
+	// reference job's manifest
+	ref := &manifest{cksumType: cos.ChecksumOneXxh}
+	ref.add("a", "", 10, cos.NewCksum(cos.ChecksumOneXxh, "aaa"))
+	ref.add("b", "", 20, cos.NewCksum(cos.ChecksumOneXxh, "bbb"))
+	ref.add("c", "", 0, nil) // (no size, no checksum)
+	ref.finalize("ref")
+
+	newLOM := func(name string, size int64, cksum string) *core.LOM {
+		lom := &core.LOM{ObjName: name}
+		tassert.CheckFatal(t, lom.InitBck(bck))
+		lom.SetSize(size)
+		if cksum != "" {
+			lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, cksum))
+		}
+		return lom
+	}
+	newTask := func(refID string) *singleTask {
+		return &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{id: "job", verifyOnly: true, verifyJob: refID}}}
+	}
+
+	// existence only
+	task := newTask("")
+	tassert.CheckError(t, task.verify(newLOM("x", 1, ""), nil))
+	err := task.verify(newLOM("x", 1, ""), os.ErrNotExist)
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "does not exist"), "expecting does-not-exist, got %v", err)
+	errIO := errors.New("I/O error")
+	tassert.Errorf(t, errors.Is(task.verify(newLOM("x", 1, ""), errIO), errIO), "expecting load error as is")
+
+	// against the manifest
+	task = newTask("ref")
+	tests := []struct {
+		name, cksum, errSub string
+		size                int64
+	}{
+		{"a", "aaa", "", 10},
+		{"b", "bbb", "", 20},
+		{"c", "any", "", 5},
+		{"a", "aaa", "size mismatch", 11},
+		{"b", "xxx", "checksum mismatch", 20},
+		{"a", "", "checksum mismatch", 10},
+		{"d", "ddd", "not recorded", 1},
+	}
+	for _, test := range tests {
+		err := task.verify(newLOM(test.name, test.size, test.cksum), nil)
+		if test.errSub == "" {
+			tassert.Errorf(t, err == nil, "%s: not expecting error, got %v", test.name, err)
+		} else {
+			tassert.Errorf(t, err != nil && strings.Contains(err.Error(), test.errSub), "%s: expecting %q, got %v",
+				test.name, test.errSub, err)
+		}
+	}
+
+	// no such manifest
+	task = newTask("no-such-job")
+	for range 2 {
+		err := task.verify(newLOM("a", 10, "aaa"), nil)
+		tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "failed to load manifest"), "expecting load error, got %v", err)
+	}
+}
+
+func TestVerifyValidate(t *testing.T) {
+	bck := cmn.Bck{Name: "bck"}
+	tests := []struct {
+		base  Base
+		valid bool
+	}{
+		{Base{Bck: bck, VerifyOnly: true}, true},
+		{Base{Bck: bck, VerifyOnly: true, VerifyJob: "ref"}, true},
+		{Base{Bck: bck, VerifyJob: "ref"}, false},
+		{Base{Bck: bck, VerifyOnly: true, ETLName: "etl"}, false},
+	}
+	for _, test := range tests {
+		err := test.base.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, got %v", test.base, test.valid, err)
+	}
+}
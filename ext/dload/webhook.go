@@ -0,0 +1,197 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Completion webhook (see Base.Webhook)
+// - each target POSTs its own summary (HookPayload) when the job finishes, aborted or not
+// - bounded retries; the outcome is recorded and reported via Job.Webhook
+// - same egress policy as downloads (see client.go)
+// - headers are kept in memory only; job info (and the persisted start request - see resume.go) shows them redacted
+
+const (
+	hookRetries = 3
+	hookTimeout = 10 * time.Second
+
+	hookRedacted = "****"
+)
+
+var hookBackoff = time.Second // doubles upon every retry (var for unit tests)
+
+type (
+	Webhook struct {
+		URL     string      `json:"url"`
+		Headers http.Header `json:"headers,omitempty"`
+	}
+	// delivery status (job info)
+	WebhookStatus struct {
+		URL     string      `json:"url"`
+		Headers http.Header `json:"headers,omitempty"` // redacted
+		Sent    int         `json:"sent"`              // number of targets that delivered
+		Failed  int         `json:"failed"`            // number of targets that failed to deliver
+		Err     string      `json:"err,omitempty"`     // last error, if any
+	}
+	// POST body
+	HookPayload struct {
+		Job
+		Target string `json:"target"`
+	}
+
+	hook struct {
+		wh     *Webhook
+		status WebhookStatus
+		mu     sync.Mutex
+		fired  bool
+	}
+)
+
+func (wh *Webhook) validate() error {
+	u, err := url.Parse(wh.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL %q: %v", wh.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid webhook URL %q: expecting http or https", wh.URL)
+	}
+	return nil
+}
+
+func newHook(wh *Webhook) *hook {
+	return &hook{wh: wh, status: WebhookStatus{URL: wh.URL, Headers: redactHeaders(wh.Headers)}}
+}
+
+// authorization, cookies, and anything that looks like a credential
+func isSecretHeader(k string) bool {
+	lk := strings.ToLower(k)
+	return strings.Contains(lk, "auth") || strings.Contains(lk, "cookie") || strings.Contains(lk, "token") ||
+		strings.Contains(lk, "secret") || strings.Contains(lk, "key") || strings.Contains(lk, "password")
+}
+
+func hasSecretHeaders(hdr http.Header) bool {
+	for k := range hdr {
+		if isSecretHeader(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeaders(hdr http.Header) http.Header {
+	if len(hdr) == 0 {
+		return nil
+	}
+	out := make(http.Header, len(hdr))
+	for k, v := range hdr {
+		if isSecretHeader(k) {
+			out[k] = []string{hookRedacted}
+		} else {
+			out[k] = append([]string(nil), v...)
+		}
+	}
+	return out
+}
+
+func (h *hook) getStatus() *WebhookStatus {
+	h.mu.Lock()
+	st := h.status
+	h.mu.Unlock()
+	return &st
+}
+
+// at most once per job (see infoStore.markFinished)
+func (h *hook) fire(j *dljob) {
+	h.mu.Lock()
+	if h.fired {
+		h.mu.Unlock()
+		return
+	}
+	h.fired = true
+	h.mu.Unlock()
+
+	payload := HookPayload{Job: j.clone(), Target: core.T.SID()}
+	go h.post(cos.MustMarshal(payload), j.id)
+}
+
+func (h *hook) post(body []byte, jobID string) {
+	var (
+		err     error
+		backoff = hookBackoff
+	)
+	for i := range hookRetries {
+		if i > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if err = h.do(body); err == nil {
+			break
+		}
+	}
+	h.mu.Lock()
+	if err == nil {
+		h.status.Sent++
+	} else {
+		h.status.Failed++
+		h.status.Err = err.Error()
+	}
+	h.mu.Unlock()
+	if err != nil {
+		nlog.Warningln("download job", jobID, "webhook failed:", err)
+	}
+}
+
+func (h *hook) do(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range h.wh.Headers {
+		req.Header[k] = v
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	resp, err := clientForURL(h.wh.URL).Do(req)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.New(h.wh.URL + ": " + resp.Status)
+	}
+	return nil
+}
+
+// (see Job.Aggregate)
+func (st *WebhookStatus) aggregate(rhs *WebhookStatus) *WebhookStatus {
+	switch {
+	case st == nil:
+		return rhs
+	case rhs == nil:
+		return st
+	}
+	out := *st
+	out.Sent += rhs.Sent
+	out.Failed += rhs.Failed
+	if out.Err == "" {
+		out.Err = rhs.Err
+	}
+	return &out
+}
@@ -0,0 +1,161 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testHookSrv struct {
+	srv    *httptest.Server
+	times  []time.Time
+	hdrs   []http.Header
+	bodies []HookPayload
+	fail   int // number of requests to fail
+	mu     sync.Mutex
+}
+
+func newTestHookSrv(t *testing.T, fail int) *testHookSrv {
+	hs := &testHookSrv{fail: fail}
+	hs.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		tassert.CheckError(t, err)
+		var payload HookPayload
+		tassert.CheckError(t, cos.JSON.Unmarshal(b, &payload))
+
+		hs.mu.Lock()
+		hs.times = append(hs.times, time.Now())
+		hs.hdrs = append(hs.hdrs, r.Header.Clone())
+		hs.bodies = append(hs.bodies, payload)
+		fail := len(hs.times) <= hs.fail
+		hs.mu.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(hs.srv.Close)
+	return hs
+}
+
+func (hs *testHookSrv) numReqs() int {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	return len(hs.times)
+}
+
+func testHookDone(t *testing.T, h *hook) *WebhookStatus {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if st := h.getStatus(); st.Sent+st.Failed > 0 {
+			return st
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("webhook: timed out waiting for delivery")
+	return nil
+}
+
+func testHookBackoff(t *testing.T) {
+	saved := hookBackoff
+	hookBackoff = 50 * time.Millisecond
+	t.Cleanup(func() { hookBackoff = saved })
+}
+
+func TestWebhookRetry(t *testing.T) {
+	testTarget(t)
+	testHookBackoff(t)
+	testStore(t, map[string]int{"job": 0})
+
+	hs := newTestHookSrv(t, hookRetries-1)
+	wh := &Webhook{URL: hs.srv.URL, Headers: http.Header{"Authorization": []string{"Bearer xyz"}}}
+	h := newHook(wh)
+	dljob := g.store.dljobs["job"]
+	dljob.finishedCnt.Store(7)
+
+	h.fire(dljob)
+	h.fire(dljob) // (at most once)
+	st := testHookDone(t, h)
+	tassert.Errorf(t, st.Sent == 1 && st.Failed == 0 && st.Err == "", "expecting delivered, got %+v", st)
+	tassert.Fatalf(t, hs.numReqs() == hookRetries, "expecting %d requests, got %d", hookRetries, hs.numReqs())
+
+	// exponential backoff
+	for i := 1; i < hookRetries; i++ {
+		gap, expected := hs.times[i].Sub(hs.times[i-1]), hookBackoff<<(i-1)
+		tassert.Errorf(t, gap >= expected, "retry %d: expecting backoff >= %v, got %v", i, expected, gap)
+	}
+	tassert.Errorf(t, hs.hdrs[0].Get("Authorization") == "Bearer xyz", "expecting headers to be sent as is")
+	tassert.Errorf(t, hs.hdrs[0].Get(cos.HdrContentType) == cos.ContentJSON, "expecting JSON content type")
+	p := hs.bodies[len(hs.bodies)-1]
+	tassert.Errorf(t, p.ID == "job" && p.FinishedCnt == 7 && p.Target != "", "unexpected payload %+v", p)
+
+	// job info: redacted
+	tassert.Errorf(t, st.Headers.Get("Authorization") == hookRedacted, "expecting redacted, got %v", st.Headers)
+}
+
+func TestWebhookFail(t *testing.T) {
+	testTarget(t)
+	testHookBackoff(t)
+	testStore(t, map[string]int{"job": 0})
+
+	hs := newTestHookSrv(t, hookRetries)
+	h := newHook(&Webhook{URL: hs.srv.URL})
+	h.fire(g.store.dljobs["job"])
+	st := testHookDone(t, h)
+	tassert.Errorf(t, st.Sent == 0 && st.Failed == 1, "expecting failed, got %+v", st)
+	tassert.Errorf(t, strings.Contains(st.Err, "503"), "expecting last error, got %q", st.Err)
+	time.Sleep(2 * hookBackoff)
+	tassert.Errorf(t, hs.numReqs() == hookRetries, "expecting %d requests (no more), got %d", hookRetries, hs.numReqs())
+}
+
+func TestWebhookValidate(t *testing.T) {
+	for url, valid := range map[string]bool{
+		"http://host:8080/hook": true,
+		"https://host/hook":     true,
+		"ftp://host/hook":       false,
+		"host/hook":             false,
+		"http://[::1":           false,
+	} {
+		err := (&Webhook{URL: url}).validate()
+		tassert.Errorf(t, (err == nil) == valid, "%q: valid=%t, got %v", url, valid, err)
+	}
+}
+
+func TestWebhookRedact(t *testing.T) {
+	hdr := http.Header{
+		"Authorization": {"a"},
+		"X-Api-Key":     {"b"},
+		"Cookie":        {"c"},
+		"X-Auth-Token":  {"d"},
+		"X-Request-Id":  {"e"},
+	}
+	out := redactHeaders(hdr)
+	for k := range hdr {
+		expected := hookRedacted
+		if k == "X-Request-Id" {
+			expected = "e"
+		}
+		tassert.Errorf(t, out.Get(k) == expected, "%s: expecting %q, got %q", k, expected, out.Get(k))
+	}
+	tassert.Errorf(t, hdr.Get("Authorization") == "a", "expecting the original intact")
+	tassert.Errorf(t, redactHeaders(nil) == nil, "expecting nil")
+}
+
+func TestWebhookAggregate(t *testing.T) {
+	var st *WebhookStatus
+	st = st.aggregate(&WebhookStatus{URL: "u", Sent: 1})
+	st = st.aggregate(&WebhookStatus{URL: "u", Failed: 1, Err: "err"})
+	st = st.aggregate(nil)
+	tassert.Errorf(t, st.Sent == 1 && st.Failed == 1 && st.Err == "err", "unexpected %+v", st)
+}
@@ -5,9 +5,12 @@
 package dload
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 	"sync"
 	"time"
 
@@ -45,8 +48,8 @@ import (
 //
 // Each jogger, which corresponds to one mountpath, has a download channel
 // (downloadCh) where download requests, that are dispatched from Dispatcher, are
-// queued. Thus, downloads occur on a per-mountpath basis and are handled one at
-// a time by jogger as they arrive.
+// queued. Thus, downloads occur on a per-mountpath basis and are handled by the
+// jogger's workers as they arrive (one worker by default - see SetWorkers).
 //
 // ====== Downloading ======
 //
@@ -57,7 +60,7 @@ import (
 // are currently full, dispatcher waits with dispatching next batch until they aren't.
 //
 // Single object's download is represented as object of `task` type, and there is at
-// most one active task assigned to any jogger at any given time. The
+// most one active task assigned to any jogger's worker at any given time. The
 // tasks are created when dispatcher wants to schedule download of an object
 // for jogger and are destroyed when the download is aborted, finished or
 // fails.
@@ -110,6 +113,8 @@ const (
 	actAbort  = "ABORT"
 	actStatus = "STATUS"
 	actList   = "LIST"
+	actCancel = "CANCEL"   // individual item (see cancel.go)
+	actPrio   = "PRIORITY" // runtime change (see prio.go)
 )
 
 type (
@@ -138,11 +143,14 @@ type (
 	// objects are used by Downloader to process the request, and are then
 	// dispatched to the correct jogger to be handled.
 	request struct {
-		action     string         // one of: adminAbort, adminList, adminStatus, adminRemove
+		action     string         // one of: adminAbort, adminList, adminStatus, adminRemove, adminCancel
 		id         string         // id of the job task
+		item       string         // object name or source link (cancel only)
 		regex      *regexp.Regexp // regex of descriptions to return if id is empty
 		response   *response      // where the outcome of the request is written
 		onlyActive bool           // request status of only active tasks
+		inflight   bool           // include in-flight items (see dispatcher.inflight)
+		priority   int            // new priority (actPrio only)
 	}
 
 	progressReader struct {
@@ -170,9 +178,7 @@ func (p *factory) Start() error {
 	xdl := newXact(p)
 	p.xctn = xdl
 
-	g.once.Do(func() {
-		g.store = newInfoStore(g.db)
-	})
+	initStore()
 
 	go xdl.Run(nil)
 	return nil
@@ -211,11 +217,32 @@ func (xld *Xact) stop() {
 	xld.Finish()
 }
 
+// Shutdown gracefully drains the downloader: stops dispatching, waits (bounded by ctx)
+// for in-flight tasks, marks still-running jobs as interrupted (not aborted),
+// flushes their state, and finally terminates the xaction.
+func (xld *Xact) Shutdown(ctx context.Context) error {
+	d := xld.dispatcher
+	d.startupSema.waitForStartup()
+
+	err := d.drain(ctx)
+	if err != nil {
+		nlog.Warningln(xld.Name(), "drain:", err)
+	}
+	g.store.interrupt(xld.ID())
+	xld.Abort(errInterrupted)
+	return err
+}
+
 func (xld *Xact) Download(job jobif) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	defer xld.DecPending()
 
+	if xld.dispatcher.draining.Load() {
+		return nil, http.StatusServiceUnavailable, fmt.Errorf("%s is shutting down", xld.Name())
+	}
+
 	dljob := g.store.setJob(job)
+	g.store.persistJob(job)
 
 	select {
 	case xld.dispatcher.workCh <- job:
@@ -238,6 +265,15 @@ func (xld *Xact) AbortJob(id string) (resp any, statusCode int, err error) {
 	return
 }
 
+// abort all running jobs with descriptions matching the regex (see AdminBody.Regex)
+func (xld *Xact) AbortMatching(descRegex *regexp.Regexp) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actAbort, regex: descRegex}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
 func (xld *Xact) RemoveJob(id string) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	req := &request{action: actRemove, id: id}
@@ -246,15 +282,33 @@ func (xld *Xact) RemoveJob(id string) (resp any, statusCode int, err error) {
 	return
 }
 
-func (xld *Xact) JobStatus(id string, onlyActive bool) (resp any, statusCode int, err error) {
+// cancel individual item of a running job (see cancel.go)
+func (xld *Xact) CancelItem(id, item string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actCancel, id: id, item: item}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
+// change priority of a running job (see prio.go)
+func (xld *Xact) SetPriority(id string, priority int) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actPrio, id: id, priority: priority}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
+func (xld *Xact) JobStatus(id string, onlyActive, inflight bool) (resp any, statusCode int, err error) {
 	xld.IncPending()
-	req := &request{action: actStatus, id: id, onlyActive: onlyActive}
+	req := &request{action: actStatus, id: id, onlyActive: onlyActive, inflight: inflight}
 	resp, statusCode, err = xld.dispatcher.adminReq(req)
 	xld.DecPending()
 	return
 }
 
-func (*Xact) CtlMsg() string       { return "" }
+func (*Xact) CtlMsg() string       { return "workers-per-mountpath:" + strconv.Itoa(Workers()) }
 func (xld *Xact) Snap() *core.Snap { return xld.Base.NewSnap(xld) }
 
 /////////////
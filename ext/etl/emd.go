@@ -54,7 +54,11 @@ type (
 	}
 )
 
-var etlMDJspOpts = jsp.CCSign(cmn.MetaverEtlMD)
+var etlMDJspOpts = func() jsp.Options {
+	opts := jsp.CCSign(cmn.MetaverEtlMD)
+	opts.Kind = jsp.KindEtlMD
+	return opts
+}()
 
 // interface guard
 var (
@@ -67,6 +67,7 @@ var _ jsp.Opts = (*VMD)(nil)
 func (*VMD) JspOpts() jsp.Options {
 	opts := jsp.CCSign(cmn.MetaverVMD)
 	opts.OldMetaverOk = 1
+	opts.Kind = jsp.KindVMD
 	return opts
 }
 